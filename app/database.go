@@ -12,6 +12,10 @@ import (
 // DB connection
 var DB *gorm.DB
 
+// DSN connection string database yang sedang terhubung,
+// digunakan oleh komponen yang butuh koneksi sendiri, eg: LISTEN/NOTIFY
+var DSN string
+
 // ConnectDatabase method to connect with db
 func ConnectDatabase() {
 	dbConf := fmt.Sprintf(
@@ -30,6 +34,7 @@ func ConnectDatabase() {
 	}
 
 	DB = database
+	DSN = dbConf
 }
 
 // CloseDatabase closing database connection
@@ -55,4 +60,5 @@ func ConnectDatabaseTest() {
 	}
 
 	DB = database
+	DSN = dbConf
 }
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -55,8 +56,6 @@ func MethodValidator() gin.HandlerFunc {
 // RequiresUserAuth middleware
 func RequiresUserAuth(c *gin.Context) {
 	auth := authHeader{}
-	authRepo := repository.NewAuthRepository()
-	userRepo := repository.NewUserRepository()
 	const bearerScheme = "Bearer "
 	if err := c.ShouldBindHeader(&auth); err != nil {
 		apiResult.Error(c, http.StatusUnauthorized, "Header `Authorization` is not set")
@@ -65,7 +64,21 @@ func RequiresUserAuth(c *gin.Context) {
 	}
 
 	tokenString := strings.ReplaceAll(auth.Authorization, bearerScheme, "")
-	accessToken, atErr := authRepo.GetAccessToken(tokenString)
+	user, err := Authenticate(tokenString)
+	if err != nil {
+		apiResult.Error(c, http.StatusUnauthorized, err.Error())
+		c.Abort()
+		return
+	}
+
+	CurrentUser = user
+	c.Next()
+}
+
+// Authenticate validasi access token lalu mengembalikan user pemiliknya,
+// digunakan juga oleh koneksi socket yang tidak melalui gin
+func Authenticate(tokenString string) (models.User, error) {
+	accessToken, atErr := repository.NewAuthRepository().GetAccessToken(tokenString)
 
 	_, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		//Make sure that the token method conform to "SigningMethodHMAC"
@@ -76,16 +89,12 @@ func RequiresUserAuth(c *gin.Context) {
 	})
 
 	if err != nil {
-		apiResult.Error(c, http.StatusUnauthorized, "Invalid Access Token")
-		c.Abort()
+		return models.User{}, errors.New("Invalid Access Token")
 	} else if atErr != nil {
-		apiResult.Error(c, http.StatusUnauthorized, "Unauthorized")
-		c.Abort()
+		return models.User{}, errors.New("Unauthorized")
 	} else if accessToken.IsExpired() {
-		apiResult.Error(c, http.StatusUnauthorized, "Access Token Expired")
-		c.Abort()
+		return models.User{}, errors.New("Access Token Expired")
 	}
 
-	CurrentUser, _ = userRepo.GetByID(accessToken.UserID)
-	c.Next()
+	return repository.NewUserRepository().GetByID(accessToken.UserID)
 }
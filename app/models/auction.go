@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+//go:generate goqueryset -in auction.go
+
+// AuctionEvent model event lelang product yang dikirim ke live stream
+// gen:qs
+type AuctionEvent struct {
+	ID        int64      `json:"id"`
+	ProductID int64      `json:"product_id"`
+	EventType string     `json:"event_type"`
+	Data      string     `json:"data"`
+	CreatedAT *time.Time `json:"created_at"`
+}
@@ -12,25 +12,25 @@ import (
 
 // ===== BEGIN of all query sets
 
-// ===== BEGIN of query set ChatHistoryQuerySet
+// ===== BEGIN of query set ChatAttachmentQuerySet
 
-// ChatHistoryQuerySet is an queryset type for ChatHistory
-type ChatHistoryQuerySet struct {
+// ChatAttachmentQuerySet is an queryset type for ChatAttachment
+type ChatAttachmentQuerySet struct {
 	db *gorm.DB
 }
 
-// NewChatHistoryQuerySet constructs new ChatHistoryQuerySet
-func NewChatHistoryQuerySet(db *gorm.DB) ChatHistoryQuerySet {
-	return ChatHistoryQuerySet{
-		db: db.Model(&ChatHistory{}),
+// NewChatAttachmentQuerySet constructs new ChatAttachmentQuerySet
+func NewChatAttachmentQuerySet(db *gorm.DB) ChatAttachmentQuerySet {
+	return ChatAttachmentQuerySet{
+		db: db.Model(&ChatAttachment{}),
 	}
 }
 
-func (qs ChatHistoryQuerySet) w(db *gorm.DB) ChatHistoryQuerySet {
-	return NewChatHistoryQuerySet(db)
+func (qs ChatAttachmentQuerySet) w(db *gorm.DB) ChatAttachmentQuerySet {
+	return NewChatAttachmentQuerySet(db)
 }
 
-func (qs ChatHistoryQuerySet) Select(fields ...ChatHistoryDBSchemaField) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) Select(fields ...ChatAttachmentDBSchemaField) ChatAttachmentQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -41,43 +41,43 @@ func (qs ChatHistoryQuerySet) Select(fields ...ChatHistoryDBSchemaField) ChatHis
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *ChatHistory) Create(db *gorm.DB) error {
+func (o *ChatAttachment) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *ChatHistory) Delete(db *gorm.DB) error {
+func (o *ChatAttachment) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) All(ret *[]ChatHistory) error {
+func (qs ChatAttachmentQuerySet) All(ret *[]ChatAttachment) error {
 	return qs.db.Find(ret).Error
 }
 
 // ChatIDEq is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDEq(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDEq(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id = ?", chatID))
 }
 
 // ChatIDGt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDGt(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDGt(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id > ?", chatID))
 }
 
 // ChatIDGte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDGte(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDGte(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id >= ?", chatID))
 }
 
 // ChatIDIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDIn(chatID ...int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDIn(chatID ...int64) ChatAttachmentQuerySet {
 	if len(chatID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDIn"))
 		return qs.w(qs.db)
@@ -87,25 +87,25 @@ func (qs ChatHistoryQuerySet) ChatIDIn(chatID ...int64) ChatHistoryQuerySet {
 
 // ChatIDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDLt(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDLt(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id < ?", chatID))
 }
 
 // ChatIDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDLte(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDLte(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id <= ?", chatID))
 }
 
 // ChatIDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDNe(chatID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDNe(chatID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("chat_id != ?", chatID))
 }
 
 // ChatIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) ChatIDNotIn(chatID ...int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) ChatIDNotIn(chatID ...int64) ChatAttachmentQuerySet {
 	if len(chatID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDNotIn"))
 		return qs.w(qs.db)
@@ -113,67 +113,251 @@ func (qs ChatHistoryQuerySet) ChatIDNotIn(chatID ...int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("chat_id NOT IN (?)", chatID))
 }
 
+// ContentTypeEq is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeEq(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type = ?", contentType))
+}
+
+// ContentTypeGt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeGt(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type > ?", contentType))
+}
+
+// ContentTypeGte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeGte(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type >= ?", contentType))
+}
+
+// ContentTypeIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeIn(contentType ...string) ChatAttachmentQuerySet {
+	if len(contentType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one contentType in ContentTypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("content_type IN (?)", contentType))
+}
+
+// ContentTypeLike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeLike(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type LIKE ?", contentType))
+}
+
+// ContentTypeLt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeLt(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type < ?", contentType))
+}
+
+// ContentTypeLte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeLte(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type <= ?", contentType))
+}
+
+// ContentTypeNe is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeNe(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type != ?", contentType))
+}
+
+// ContentTypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeNotIn(contentType ...string) ChatAttachmentQuerySet {
+	if len(contentType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one contentType in ContentTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("content_type NOT IN (?)", contentType))
+}
+
+// ContentTypeNotlike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) ContentTypeNotlike(contentType string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("content_type NOT LIKE ?", contentType))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) Count() (int, error) {
+func (qs ChatAttachmentQuerySet) Count() (int, error) {
 	var count int
 	err := qs.db.Count(&count).Error
 	return count, err
 }
 
+// CreatedATEq is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATEq(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAT))
+}
+
+// CreatedATGt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATGt(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAT))
+}
+
+// CreatedATGte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATGte(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAT))
+}
+
+// CreatedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATIsNotNull() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at IS NOT NULL"))
+}
+
+// CreatedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATIsNull() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at IS NULL"))
+}
+
+// CreatedATLt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATLt(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAT))
+}
+
+// CreatedATLte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATLte(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAT))
+}
+
+// CreatedATNe is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) CreatedATNe(createdAT time.Time) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAT))
+}
+
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) Delete() error {
-	return qs.db.Delete(ChatHistory{}).Error
+func (qs ChatAttachmentQuerySet) Delete() error {
+	return qs.db.Delete(ChatAttachment{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(ChatHistory{})
+func (qs ChatAttachmentQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ChatAttachment{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(ChatHistory{})
+func (qs ChatAttachmentQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ChatAttachment{})
 	return db.RowsAffected, db.Error
 }
 
+// FileNameEq is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameEq(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name = ?", fileName))
+}
+
+// FileNameGt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameGt(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name > ?", fileName))
+}
+
+// FileNameGte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameGte(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name >= ?", fileName))
+}
+
+// FileNameIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameIn(fileName ...string) ChatAttachmentQuerySet {
+	if len(fileName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fileName in FileNameIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("file_name IN (?)", fileName))
+}
+
+// FileNameLike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameLike(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name LIKE ?", fileName))
+}
+
+// FileNameLt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameLt(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name < ?", fileName))
+}
+
+// FileNameLte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameLte(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name <= ?", fileName))
+}
+
+// FileNameNe is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameNe(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name != ?", fileName))
+}
+
+// FileNameNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameNotIn(fileName ...string) ChatAttachmentQuerySet {
+	if len(fileName) == 0 {
+		qs.db.AddError(errors.New("must at least pass one fileName in FileNameNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("file_name NOT IN (?)", fileName))
+}
+
+// FileNameNotlike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) FileNameNotlike(fileName string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("file_name NOT LIKE ?", fileName))
+}
+
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) GetDB() *gorm.DB {
+func (qs ChatAttachmentQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) GetUpdater() ChatHistoryUpdater {
-	return NewChatHistoryUpdater(qs.db)
+func (qs ChatAttachmentQuerySet) GetUpdater() ChatAttachmentUpdater {
+	return NewChatAttachmentUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDEq(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDEq(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id = ?", ID))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDGt(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDGt(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id > ?", ID))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDGte(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDGte(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id >= ?", ID))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDIn(ID ...int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDIn(ID ...int64) ChatAttachmentQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
@@ -183,25 +367,25 @@ func (qs ChatHistoryQuerySet) IDIn(ID ...int64) ChatHistoryQuerySet {
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDLt(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDLt(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id < ?", ID))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDLte(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDLte(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id <= ?", ID))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDNe(ID int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDNe(ID int64) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Where("id != ?", ID))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) IDNotIn(ID ...int64) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) IDNotIn(ID ...int64) ChatAttachmentQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
@@ -209,260 +393,494 @@ func (qs ChatHistoryQuerySet) IDNotIn(ID ...int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
-// Limit is an autogenerated method
-// nolint: dupl
-func (qs ChatHistoryQuerySet) Limit(limit int) ChatHistoryQuerySet {
-	return qs.w(qs.db.Limit(limit))
-}
-
-// MessageIDEq is an autogenerated method
+// KindEq is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDEq(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id = ?", messageID))
+func (qs ChatAttachmentQuerySet) KindEq(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind = ?", kind))
 }
 
-// MessageIDGt is an autogenerated method
+// KindGt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDGt(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id > ?", messageID))
+func (qs ChatAttachmentQuerySet) KindGt(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind > ?", kind))
 }
 
-// MessageIDGte is an autogenerated method
+// KindGte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDGte(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id >= ?", messageID))
+func (qs ChatAttachmentQuerySet) KindGte(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind >= ?", kind))
 }
 
-// MessageIDIn is an autogenerated method
+// KindIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDIn(messageID ...int64) ChatHistoryQuerySet {
-	if len(messageID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one messageID in MessageIDIn"))
+func (qs ChatAttachmentQuerySet) KindIn(kind ...int) ChatAttachmentQuerySet {
+	if len(kind) == 0 {
+		qs.db.AddError(errors.New("must at least pass one kind in KindIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("message_id IN (?)", messageID))
+	return qs.w(qs.db.Where("kind IN (?)", kind))
 }
 
-// MessageIDLt is an autogenerated method
+// KindLt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDLt(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id < ?", messageID))
+func (qs ChatAttachmentQuerySet) KindLt(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind < ?", kind))
 }
 
-// MessageIDLte is an autogenerated method
+// KindLte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDLte(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id <= ?", messageID))
+func (qs ChatAttachmentQuerySet) KindLte(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind <= ?", kind))
 }
 
-// MessageIDNe is an autogenerated method
+// KindNe is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDNe(messageID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("message_id != ?", messageID))
+func (qs ChatAttachmentQuerySet) KindNe(kind int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("kind != ?", kind))
 }
 
-// MessageIDNotIn is an autogenerated method
+// KindNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) MessageIDNotIn(messageID ...int64) ChatHistoryQuerySet {
-	if len(messageID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one messageID in MessageIDNotIn"))
+func (qs ChatAttachmentQuerySet) KindNotIn(kind ...int) ChatAttachmentQuerySet {
+	if len(kind) == 0 {
+		qs.db.AddError(errors.New("must at least pass one kind in KindNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("message_id NOT IN (?)", messageID))
+	return qs.w(qs.db.Where("kind NOT IN (?)", kind))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) Limit(limit int) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
 // Offset is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) Offset(offset int) ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) Offset(offset int) ChatAttachmentQuerySet {
 	return qs.w(qs.db.Offset(offset))
 }
 
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
-func (qs ChatHistoryQuerySet) One(ret *ChatHistory) error {
+func (qs ChatAttachmentQuerySet) One(ret *ChatAttachment) error {
 	return qs.db.First(ret).Error
 }
 
 // OrderAscByChatID is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderAscByChatID() ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) OrderAscByChatID() ChatAttachmentQuerySet {
 	return qs.w(qs.db.Order("chat_id ASC"))
 }
 
+// OrderAscByContentType is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderAscByContentType() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("content_type ASC"))
+}
+
+// OrderAscByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderAscByCreatedAT() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByFileName is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderAscByFileName() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("file_name ASC"))
+}
+
 // OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderAscByID() ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) OrderAscByID() ChatAttachmentQuerySet {
 	return qs.w(qs.db.Order("id ASC"))
 }
 
-// OrderAscByMessageID is an autogenerated method
+// OrderAscByKind is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderAscByMessageID() ChatHistoryQuerySet {
-	return qs.w(qs.db.Order("message_id ASC"))
+func (qs ChatAttachmentQuerySet) OrderAscByKind() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("kind ASC"))
 }
 
-// OrderAscByOwnerID is an autogenerated method
+// OrderAscBySize is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderAscByOwnerID() ChatHistoryQuerySet {
-	return qs.w(qs.db.Order("owner_id ASC"))
+func (qs ChatAttachmentQuerySet) OrderAscBySize() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("size ASC"))
+}
+
+// OrderAscByStorageKey is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderAscByStorageKey() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("storage_key ASC"))
+}
+
+// OrderAscByUploaderID is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderAscByUploaderID() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("uploader_id ASC"))
 }
 
 // OrderDescByChatID is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderDescByChatID() ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) OrderDescByChatID() ChatAttachmentQuerySet {
 	return qs.w(qs.db.Order("chat_id DESC"))
 }
 
+// OrderDescByContentType is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderDescByContentType() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("content_type DESC"))
+}
+
+// OrderDescByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderDescByCreatedAT() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByFileName is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) OrderDescByFileName() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("file_name DESC"))
+}
+
 // OrderDescByID is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderDescByID() ChatHistoryQuerySet {
+func (qs ChatAttachmentQuerySet) OrderDescByID() ChatAttachmentQuerySet {
 	return qs.w(qs.db.Order("id DESC"))
 }
 
-// OrderDescByMessageID is an autogenerated method
+// OrderDescByKind is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderDescByMessageID() ChatHistoryQuerySet {
-	return qs.w(qs.db.Order("message_id DESC"))
+func (qs ChatAttachmentQuerySet) OrderDescByKind() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("kind DESC"))
 }
 
-// OrderDescByOwnerID is an autogenerated method
+// OrderDescBySize is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OrderDescByOwnerID() ChatHistoryQuerySet {
-	return qs.w(qs.db.Order("owner_id DESC"))
+func (qs ChatAttachmentQuerySet) OrderDescBySize() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("size DESC"))
 }
 
-// OwnerIDEq is an autogenerated method
+// OrderDescByStorageKey is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDEq(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id = ?", ownerID))
+func (qs ChatAttachmentQuerySet) OrderDescByStorageKey() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("storage_key DESC"))
 }
 
-// OwnerIDGt is an autogenerated method
+// OrderDescByUploaderID is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDGt(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id > ?", ownerID))
+func (qs ChatAttachmentQuerySet) OrderDescByUploaderID() ChatAttachmentQuerySet {
+	return qs.w(qs.db.Order("uploader_id DESC"))
 }
 
-// OwnerIDGte is an autogenerated method
+// SizeEq is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDGte(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id >= ?", ownerID))
+func (qs ChatAttachmentQuerySet) SizeEq(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size = ?", size))
 }
 
-// OwnerIDIn is an autogenerated method
+// SizeGt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDIn(ownerID ...int64) ChatHistoryQuerySet {
-	if len(ownerID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDIn"))
+func (qs ChatAttachmentQuerySet) SizeGt(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size > ?", size))
+}
+
+// SizeGte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) SizeGte(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size >= ?", size))
+}
+
+// SizeIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) SizeIn(size ...int64) ChatAttachmentQuerySet {
+	if len(size) == 0 {
+		qs.db.AddError(errors.New("must at least pass one size in SizeIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("owner_id IN (?)", ownerID))
+	return qs.w(qs.db.Where("size IN (?)", size))
 }
 
-// OwnerIDLt is an autogenerated method
+// SizeLt is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDLt(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id < ?", ownerID))
+func (qs ChatAttachmentQuerySet) SizeLt(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size < ?", size))
 }
 
-// OwnerIDLte is an autogenerated method
+// SizeLte is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDLte(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id <= ?", ownerID))
+func (qs ChatAttachmentQuerySet) SizeLte(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size <= ?", size))
 }
 
-// OwnerIDNe is an autogenerated method
+// SizeNe is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDNe(ownerID int64) ChatHistoryQuerySet {
-	return qs.w(qs.db.Where("owner_id != ?", ownerID))
+func (qs ChatAttachmentQuerySet) SizeNe(size int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("size != ?", size))
 }
 
-// OwnerIDNotIn is an autogenerated method
+// SizeNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatHistoryQuerySet) OwnerIDNotIn(ownerID ...int64) ChatHistoryQuerySet {
-	if len(ownerID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDNotIn"))
+func (qs ChatAttachmentQuerySet) SizeNotIn(size ...int64) ChatAttachmentQuerySet {
+	if len(size) == 0 {
+		qs.db.AddError(errors.New("must at least pass one size in SizeNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("owner_id NOT IN (?)", ownerID))
+	return qs.w(qs.db.Where("size NOT IN (?)", size))
 }
 
-// SetChatID is an autogenerated method
+// StorageKeyEq is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) SetChatID(chatID int64) ChatHistoryUpdater {
-	u.fields[string(ChatHistoryDBSchema.ChatID)] = chatID
-	return u
+func (qs ChatAttachmentQuerySet) StorageKeyEq(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key = ?", storageKey))
 }
 
-// SetID is an autogenerated method
+// StorageKeyGt is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) SetID(ID int64) ChatHistoryUpdater {
-	u.fields[string(ChatHistoryDBSchema.ID)] = ID
-	return u
+func (qs ChatAttachmentQuerySet) StorageKeyGt(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key > ?", storageKey))
 }
 
-// SetMessageID is an autogenerated method
+// StorageKeyGte is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) SetMessageID(messageID int64) ChatHistoryUpdater {
-	u.fields[string(ChatHistoryDBSchema.MessageID)] = messageID
+func (qs ChatAttachmentQuerySet) StorageKeyGte(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key >= ?", storageKey))
+}
+
+// StorageKeyIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyIn(storageKey ...string) ChatAttachmentQuerySet {
+	if len(storageKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one storageKey in StorageKeyIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("storage_key IN (?)", storageKey))
+}
+
+// StorageKeyLike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyLike(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key LIKE ?", storageKey))
+}
+
+// StorageKeyLt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyLt(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key < ?", storageKey))
+}
+
+// StorageKeyLte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyLte(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key <= ?", storageKey))
+}
+
+// StorageKeyNe is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyNe(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key != ?", storageKey))
+}
+
+// StorageKeyNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyNotIn(storageKey ...string) ChatAttachmentQuerySet {
+	if len(storageKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one storageKey in StorageKeyNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("storage_key NOT IN (?)", storageKey))
+}
+
+// StorageKeyNotlike is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) StorageKeyNotlike(storageKey string) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("storage_key NOT LIKE ?", storageKey))
+}
+
+// UploaderIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDEq(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id = ?", uploaderID))
+}
+
+// UploaderIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDGt(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id > ?", uploaderID))
+}
+
+// UploaderIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDGte(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id >= ?", uploaderID))
+}
+
+// UploaderIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDIn(uploaderID ...int64) ChatAttachmentQuerySet {
+	if len(uploaderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one uploaderID in UploaderIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("uploader_id IN (?)", uploaderID))
+}
+
+// UploaderIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDLt(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id < ?", uploaderID))
+}
+
+// UploaderIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDLte(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id <= ?", uploaderID))
+}
+
+// UploaderIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDNe(uploaderID int64) ChatAttachmentQuerySet {
+	return qs.w(qs.db.Where("uploader_id != ?", uploaderID))
+}
+
+// UploaderIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatAttachmentQuerySet) UploaderIDNotIn(uploaderID ...int64) ChatAttachmentQuerySet {
+	if len(uploaderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one uploaderID in UploaderIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("uploader_id NOT IN (?)", uploaderID))
+}
+
+// SetChatID is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetChatID(chatID int64) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.ChatID)] = chatID
 	return u
 }
 
-// SetOwnerID is an autogenerated method
+// SetContentType is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) SetOwnerID(ownerID int64) ChatHistoryUpdater {
-	u.fields[string(ChatHistoryDBSchema.OwnerID)] = ownerID
+func (u ChatAttachmentUpdater) SetContentType(contentType string) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.ContentType)] = contentType
+	return u
+}
+
+// SetCreatedAT is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetCreatedAT(createdAT *time.Time) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// SetFileName is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetFileName(fileName string) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.FileName)] = fileName
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetID(ID int64) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.ID)] = ID
+	return u
+}
+
+// SetKind is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetKind(kind int) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.Kind)] = kind
+	return u
+}
+
+// SetSize is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetSize(size int64) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.Size)] = size
+	return u
+}
+
+// SetStorageKey is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetStorageKey(storageKey string) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.StorageKey)] = storageKey
+	return u
+}
+
+// SetUploaderID is an autogenerated method
+// nolint: dupl
+func (u ChatAttachmentUpdater) SetUploaderID(uploaderID int64) ChatAttachmentUpdater {
+	u.fields[string(ChatAttachmentDBSchema.UploaderID)] = uploaderID
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) Update() error {
+func (u ChatAttachmentUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u ChatHistoryUpdater) UpdateNum() (int64, error) {
+func (u ChatAttachmentUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set ChatHistoryQuerySet
+// ===== END of query set ChatAttachmentQuerySet
 
-// ===== BEGIN of ChatHistory modifiers
+// ===== BEGIN of ChatAttachment modifiers
 
-// ChatHistoryDBSchemaField describes database schema field. It requires for method 'Update'
-type ChatHistoryDBSchemaField string
+// ChatAttachmentDBSchemaField describes database schema field. It requires for method 'Update'
+type ChatAttachmentDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f ChatHistoryDBSchemaField) String() string {
+func (f ChatAttachmentDBSchemaField) String() string {
 	return string(f)
 }
 
-// ChatHistoryDBSchema stores db field names of ChatHistory
-var ChatHistoryDBSchema = struct {
-	ID        ChatHistoryDBSchemaField
-	ChatID    ChatHistoryDBSchemaField
-	OwnerID   ChatHistoryDBSchemaField
-	MessageID ChatHistoryDBSchemaField
+// ChatAttachmentDBSchema stores db field names of ChatAttachment
+var ChatAttachmentDBSchema = struct {
+	ID          ChatAttachmentDBSchemaField
+	ChatID      ChatAttachmentDBSchemaField
+	UploaderID  ChatAttachmentDBSchemaField
+	StorageKey  ChatAttachmentDBSchemaField
+	FileName    ChatAttachmentDBSchemaField
+	ContentType ChatAttachmentDBSchemaField
+	Kind        ChatAttachmentDBSchemaField
+	Size        ChatAttachmentDBSchemaField
+	CreatedAT   ChatAttachmentDBSchemaField
 }{
 
-	ID:        ChatHistoryDBSchemaField("id"),
-	ChatID:    ChatHistoryDBSchemaField("chat_id"),
-	OwnerID:   ChatHistoryDBSchemaField("owner_id"),
-	MessageID: ChatHistoryDBSchemaField("message_id"),
+	ID:          ChatAttachmentDBSchemaField("id"),
+	ChatID:      ChatAttachmentDBSchemaField("chat_id"),
+	UploaderID:  ChatAttachmentDBSchemaField("uploader_id"),
+	StorageKey:  ChatAttachmentDBSchemaField("storage_key"),
+	FileName:    ChatAttachmentDBSchemaField("file_name"),
+	ContentType: ChatAttachmentDBSchemaField("content_type"),
+	Kind:        ChatAttachmentDBSchemaField("kind"),
+	Size:        ChatAttachmentDBSchemaField("size"),
+	CreatedAT:   ChatAttachmentDBSchemaField("created_at"),
 }
 
-// Update updates ChatHistory fields by primary key
+// Update updates ChatAttachment fields by primary key
 // nolint: dupl
-func (o *ChatHistory) Update(db *gorm.DB, fields ...ChatHistoryDBSchemaField) error {
+func (o *ChatAttachment) Update(db *gorm.DB, fields ...ChatAttachmentDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"chat_id":    o.ChatID,
-		"owner_id":   o.OwnerID,
-		"message_id": o.MessageID,
+		"id":           o.ID,
+		"chat_id":      o.ChatID,
+		"uploader_id":  o.UploaderID,
+		"storage_key":  o.StorageKey,
+		"file_name":    o.FileName,
+		"content_type": o.ContentType,
+		"kind":         o.Kind,
+		"size":         o.Size,
+		"created_at":   o.CreatedAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -474,49 +892,49 @@ func (o *ChatHistory) Update(db *gorm.DB, fields ...ChatHistoryDBSchemaField) er
 			return err
 		}
 
-		return fmt.Errorf("can't update ChatHistory %v fields %v: %s",
+		return fmt.Errorf("can't update ChatAttachment %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// ChatHistoryUpdater is an ChatHistory updates manager
-type ChatHistoryUpdater struct {
+// ChatAttachmentUpdater is an ChatAttachment updates manager
+type ChatAttachmentUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewChatHistoryUpdater creates new ChatHistory updater
+// NewChatAttachmentUpdater creates new ChatAttachment updater
 // nolint: dupl
-func NewChatHistoryUpdater(db *gorm.DB) ChatHistoryUpdater {
-	return ChatHistoryUpdater{
+func NewChatAttachmentUpdater(db *gorm.DB) ChatAttachmentUpdater {
+	return ChatAttachmentUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&ChatHistory{}),
+		db:     db.Model(&ChatAttachment{}),
 	}
 }
 
-// ===== END of ChatHistory modifiers
+// ===== END of ChatAttachment modifiers
 
-// ===== BEGIN of query set ChatQuerySet
+// ===== BEGIN of query set ChatHistoryQuerySet
 
-// ChatQuerySet is an queryset type for Chat
-type ChatQuerySet struct {
+// ChatHistoryQuerySet is an queryset type for ChatHistory
+type ChatHistoryQuerySet struct {
 	db *gorm.DB
 }
 
-// NewChatQuerySet constructs new ChatQuerySet
-func NewChatQuerySet(db *gorm.DB) ChatQuerySet {
-	return ChatQuerySet{
-		db: db.Model(&Chat{}),
+// NewChatHistoryQuerySet constructs new ChatHistoryQuerySet
+func NewChatHistoryQuerySet(db *gorm.DB) ChatHistoryQuerySet {
+	return ChatHistoryQuerySet{
+		db: db.Model(&ChatHistory{}),
 	}
 }
 
-func (qs ChatQuerySet) w(db *gorm.DB) ChatQuerySet {
-	return NewChatQuerySet(db)
+func (qs ChatHistoryQuerySet) w(db *gorm.DB) ChatHistoryQuerySet {
+	return NewChatHistoryQuerySet(db)
 }
 
-func (qs ChatQuerySet) Select(fields ...ChatDBSchemaField) ChatQuerySet {
+func (qs ChatHistoryQuerySet) Select(fields ...ChatHistoryDBSchemaField) ChatHistoryQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -527,25 +945,81 @@ func (qs ChatQuerySet) Select(fields ...ChatDBSchemaField) ChatQuerySet {
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *Chat) Create(db *gorm.DB) error {
+func (o *ChatHistory) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *Chat) Delete(db *gorm.DB) error {
+func (o *ChatHistory) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) All(ret *[]Chat) error {
+func (qs ChatHistoryQuerySet) All(ret *[]ChatHistory) error {
 	return qs.db.Find(ret).Error
 }
 
+// ChatIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDEq(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id = ?", chatID))
+}
+
+// ChatIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDGt(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id > ?", chatID))
+}
+
+// ChatIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDGte(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id >= ?", chatID))
+}
+
+// ChatIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDIn(chatID ...int64) ChatHistoryQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("chat_id IN (?)", chatID))
+}
+
+// ChatIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDLt(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id < ?", chatID))
+}
+
+// ChatIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDLte(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id <= ?", chatID))
+}
+
+// ChatIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDNe(chatID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("chat_id != ?", chatID))
+}
+
+// ChatIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) ChatIDNotIn(chatID ...int64) ChatHistoryQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("chat_id NOT IN (?)", chatID))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) Count() (int, error) {
+func (qs ChatHistoryQuerySet) Count() (int, error) {
 	var count int
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -553,57 +1027,57 @@ func (qs ChatQuerySet) Count() (int, error) {
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) Delete() error {
-	return qs.db.Delete(Chat{}).Error
+func (qs ChatHistoryQuerySet) Delete() error {
+	return qs.db.Delete(ChatHistory{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(Chat{})
+func (qs ChatHistoryQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ChatHistory{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(Chat{})
+func (qs ChatHistoryQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ChatHistory{})
 	return db.RowsAffected, db.Error
 }
 
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) GetDB() *gorm.DB {
+func (qs ChatHistoryQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) GetUpdater() ChatUpdater {
-	return NewChatUpdater(qs.db)
+func (qs ChatHistoryQuerySet) GetUpdater() ChatHistoryUpdater {
+	return NewChatHistoryUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDEq(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDEq(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id = ?", ID))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDGt(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDGt(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id > ?", ID))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDGte(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDGte(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id >= ?", ID))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDIn(ID ...int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDIn(ID ...int64) ChatHistoryQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
@@ -613,25 +1087,25 @@ func (qs ChatQuerySet) IDIn(ID ...int64) ChatQuerySet {
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDLt(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDLt(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id < ?", ID))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDLte(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDLte(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id <= ?", ID))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDNe(ID int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDNe(ID int64) ChatHistoryQuerySet {
 	return qs.w(qs.db.Where("id != ?", ID))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) IDNotIn(ID ...int64) ChatQuerySet {
+func (qs ChatHistoryQuerySet) IDNotIn(ID ...int64) ChatHistoryQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
@@ -639,378 +1113,1776 @@ func (qs ChatQuerySet) IDNotIn(ID ...int64) ChatQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
-// InitiatorIDEq is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDEq(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id = ?", initiatorID))
+func (qs ChatHistoryQuerySet) Limit(limit int) ChatHistoryQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// InitiatorIDGt is an autogenerated method
+// MessageIDEq is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDGt(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id > ?", initiatorID))
+func (qs ChatHistoryQuerySet) MessageIDEq(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id = ?", messageID))
 }
 
-// InitiatorIDGte is an autogenerated method
+// MessageIDGt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDGte(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id >= ?", initiatorID))
-}
+func (qs ChatHistoryQuerySet) MessageIDGt(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id > ?", messageID))
+}
+
+// MessageIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDGte(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id >= ?", messageID))
+}
+
+// MessageIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDIn(messageID ...int64) ChatHistoryQuerySet {
+	if len(messageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one messageID in MessageIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("message_id IN (?)", messageID))
+}
+
+// MessageIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDLt(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id < ?", messageID))
+}
+
+// MessageIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDLte(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id <= ?", messageID))
+}
+
+// MessageIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDNe(messageID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("message_id != ?", messageID))
+}
+
+// MessageIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) MessageIDNotIn(messageID ...int64) ChatHistoryQuerySet {
+	if len(messageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one messageID in MessageIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("message_id NOT IN (?)", messageID))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) Offset(offset int) ChatHistoryQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ChatHistoryQuerySet) One(ret *ChatHistory) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByChatID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderAscByChatID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("chat_id ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderAscByID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByMessageID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderAscByMessageID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("message_id ASC"))
+}
+
+// OrderAscByOwnerID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderAscByOwnerID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("owner_id ASC"))
+}
+
+// OrderDescByChatID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderDescByChatID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("chat_id DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderDescByID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByMessageID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderDescByMessageID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("message_id DESC"))
+}
+
+// OrderDescByOwnerID is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OrderDescByOwnerID() ChatHistoryQuerySet {
+	return qs.w(qs.db.Order("owner_id DESC"))
+}
+
+// OwnerIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDEq(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id = ?", ownerID))
+}
+
+// OwnerIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDGt(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id > ?", ownerID))
+}
+
+// OwnerIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDGte(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id >= ?", ownerID))
+}
+
+// OwnerIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDIn(ownerID ...int64) ChatHistoryQuerySet {
+	if len(ownerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_id IN (?)", ownerID))
+}
+
+// OwnerIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDLt(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id < ?", ownerID))
+}
+
+// OwnerIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDLte(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id <= ?", ownerID))
+}
+
+// OwnerIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDNe(ownerID int64) ChatHistoryQuerySet {
+	return qs.w(qs.db.Where("owner_id != ?", ownerID))
+}
+
+// OwnerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatHistoryQuerySet) OwnerIDNotIn(ownerID ...int64) ChatHistoryQuerySet {
+	if len(ownerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ownerID in OwnerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("owner_id NOT IN (?)", ownerID))
+}
+
+// SetChatID is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) SetChatID(chatID int64) ChatHistoryUpdater {
+	u.fields[string(ChatHistoryDBSchema.ChatID)] = chatID
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) SetID(ID int64) ChatHistoryUpdater {
+	u.fields[string(ChatHistoryDBSchema.ID)] = ID
+	return u
+}
+
+// SetMessageID is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) SetMessageID(messageID int64) ChatHistoryUpdater {
+	u.fields[string(ChatHistoryDBSchema.MessageID)] = messageID
+	return u
+}
+
+// SetOwnerID is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) SetOwnerID(ownerID int64) ChatHistoryUpdater {
+	u.fields[string(ChatHistoryDBSchema.OwnerID)] = ownerID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ChatHistoryUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set ChatHistoryQuerySet
+
+// ===== BEGIN of ChatHistory modifiers
+
+// ChatHistoryDBSchemaField describes database schema field. It requires for method 'Update'
+type ChatHistoryDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ChatHistoryDBSchemaField) String() string {
+	return string(f)
+}
+
+// ChatHistoryDBSchema stores db field names of ChatHistory
+var ChatHistoryDBSchema = struct {
+	ID        ChatHistoryDBSchemaField
+	ChatID    ChatHistoryDBSchemaField
+	OwnerID   ChatHistoryDBSchemaField
+	MessageID ChatHistoryDBSchemaField
+}{
+
+	ID:        ChatHistoryDBSchemaField("id"),
+	ChatID:    ChatHistoryDBSchemaField("chat_id"),
+	OwnerID:   ChatHistoryDBSchemaField("owner_id"),
+	MessageID: ChatHistoryDBSchemaField("message_id"),
+}
+
+// Update updates ChatHistory fields by primary key
+// nolint: dupl
+func (o *ChatHistory) Update(db *gorm.DB, fields ...ChatHistoryDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"chat_id":    o.ChatID,
+		"owner_id":   o.OwnerID,
+		"message_id": o.MessageID,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update ChatHistory %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// ChatHistoryUpdater is an ChatHistory updates manager
+type ChatHistoryUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewChatHistoryUpdater creates new ChatHistory updater
+// nolint: dupl
+func NewChatHistoryUpdater(db *gorm.DB) ChatHistoryUpdater {
+	return ChatHistoryUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&ChatHistory{}),
+	}
+}
+
+// ===== END of ChatHistory modifiers
+
+// ===== BEGIN of query set ChatProductQuerySet
+
+// ChatProductQuerySet is an queryset type for ChatProduct
+type ChatProductQuerySet struct {
+	db *gorm.DB
+}
+
+// NewChatProductQuerySet constructs new ChatProductQuerySet
+func NewChatProductQuerySet(db *gorm.DB) ChatProductQuerySet {
+	return ChatProductQuerySet{
+		db: db.Model(&ChatProduct{}),
+	}
+}
+
+func (qs ChatProductQuerySet) w(db *gorm.DB) ChatProductQuerySet {
+	return NewChatProductQuerySet(db)
+}
+
+func (qs ChatProductQuerySet) Select(fields ...ChatProductDBSchemaField) ChatProductQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *ChatProduct) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *ChatProduct) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) All(ret *[]ChatProduct) error {
+	return qs.db.Find(ret).Error
+}
+
+// ChatIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDEq(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id = ?", chatID))
+}
+
+// ChatIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDGt(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id > ?", chatID))
+}
+
+// ChatIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDGte(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id >= ?", chatID))
+}
+
+// ChatIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDIn(chatID ...int64) ChatProductQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("chat_id IN (?)", chatID))
+}
+
+// ChatIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDLt(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id < ?", chatID))
+}
+
+// ChatIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDLte(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id <= ?", chatID))
+}
+
+// ChatIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDNe(chatID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("chat_id != ?", chatID))
+}
+
+// ChatIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ChatIDNotIn(chatID ...int64) ChatProductQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("chat_id NOT IN (?)", chatID))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) Delete() error {
+	return qs.db.Delete(ChatProduct{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ChatProduct{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ChatProduct{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) GetUpdater() ChatProductUpdater {
+	return NewChatProductUpdater(qs.db)
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) Limit(limit int) ChatProductQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) Offset(offset int) ChatProductQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ChatProductQuerySet) One(ret *ChatProduct) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByChatID is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderAscByChatID() ChatProductQuerySet {
+	return qs.w(qs.db.Order("chat_id ASC"))
+}
+
+// OrderAscByProductID is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderAscByProductID() ChatProductQuerySet {
+	return qs.w(qs.db.Order("product_id ASC"))
+}
+
+// OrderAscByTS is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderAscByTS() ChatProductQuerySet {
+	return qs.w(qs.db.Order("ts ASC"))
+}
+
+// OrderDescByChatID is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderDescByChatID() ChatProductQuerySet {
+	return qs.w(qs.db.Order("chat_id DESC"))
+}
+
+// OrderDescByProductID is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderDescByProductID() ChatProductQuerySet {
+	return qs.w(qs.db.Order("product_id DESC"))
+}
+
+// OrderDescByTS is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) OrderDescByTS() ChatProductQuerySet {
+	return qs.w(qs.db.Order("ts DESC"))
+}
+
+// ProductIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDEq(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id = ?", productID))
+}
+
+// ProductIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDGt(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id > ?", productID))
+}
+
+// ProductIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDGte(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id >= ?", productID))
+}
+
+// ProductIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDIn(productID ...int64) ChatProductQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id IN (?)", productID))
+}
+
+// ProductIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDLt(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id < ?", productID))
+}
+
+// ProductIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDLte(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id <= ?", productID))
+}
+
+// ProductIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDNe(productID int64) ChatProductQuerySet {
+	return qs.w(qs.db.Where("product_id != ?", productID))
+}
+
+// ProductIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) ProductIDNotIn(productID ...int64) ChatProductQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
+}
+
+// TSEq is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSEq(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts = ?", tS))
+}
+
+// TSGt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSGt(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts > ?", tS))
+}
+
+// TSGte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSGte(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts >= ?", tS))
+}
+
+// TSIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSIsNotNull() ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts IS NOT NULL"))
+}
+
+// TSIsNull is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSIsNull() ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts IS NULL"))
+}
+
+// TSLt is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSLt(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts < ?", tS))
+}
+
+// TSLte is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSLte(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts <= ?", tS))
+}
+
+// TSNe is an autogenerated method
+// nolint: dupl
+func (qs ChatProductQuerySet) TSNe(tS time.Time) ChatProductQuerySet {
+	return qs.w(qs.db.Where("ts != ?", tS))
+}
+
+// SetChatID is an autogenerated method
+// nolint: dupl
+func (u ChatProductUpdater) SetChatID(chatID int64) ChatProductUpdater {
+	u.fields[string(ChatProductDBSchema.ChatID)] = chatID
+	return u
+}
+
+// SetProductID is an autogenerated method
+// nolint: dupl
+func (u ChatProductUpdater) SetProductID(productID int64) ChatProductUpdater {
+	u.fields[string(ChatProductDBSchema.ProductID)] = productID
+	return u
+}
+
+// SetTS is an autogenerated method
+// nolint: dupl
+func (u ChatProductUpdater) SetTS(tS *time.Time) ChatProductUpdater {
+	u.fields[string(ChatProductDBSchema.TS)] = tS
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ChatProductUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ChatProductUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set ChatProductQuerySet
+
+// ===== BEGIN of ChatProduct modifiers
+
+// ChatProductDBSchemaField describes database schema field. It requires for method 'Update'
+type ChatProductDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ChatProductDBSchemaField) String() string {
+	return string(f)
+}
+
+// ChatProductDBSchema stores db field names of ChatProduct
+var ChatProductDBSchema = struct {
+	ChatID    ChatProductDBSchemaField
+	ProductID ChatProductDBSchemaField
+	TS        ChatProductDBSchemaField
+}{
+
+	ChatID:    ChatProductDBSchemaField("chat_id"),
+	ProductID: ChatProductDBSchemaField("product_id"),
+	TS:        ChatProductDBSchemaField("ts"),
+}
+
+// Update updates ChatProduct fields by primary key
+// nolint: dupl
+func (o *ChatProduct) Update(db *gorm.DB, fields ...ChatProductDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"chat_id":    o.ChatID,
+		"product_id": o.ProductID,
+		"ts":         o.TS,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update ChatProduct %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// ChatProductUpdater is an ChatProduct updates manager
+type ChatProductUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewChatProductUpdater creates new ChatProduct updater
+// nolint: dupl
+func NewChatProductUpdater(db *gorm.DB) ChatProductUpdater {
+	return ChatProductUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&ChatProduct{}),
+	}
+}
+
+// ===== END of ChatProduct modifiers
+
+// ===== BEGIN of query set ChatQuerySet
+
+// ChatQuerySet is an queryset type for Chat
+type ChatQuerySet struct {
+	db *gorm.DB
+}
+
+// NewChatQuerySet constructs new ChatQuerySet
+func NewChatQuerySet(db *gorm.DB) ChatQuerySet {
+	return ChatQuerySet{
+		db: db.Model(&Chat{}),
+	}
+}
+
+func (qs ChatQuerySet) w(db *gorm.DB) ChatQuerySet {
+	return NewChatQuerySet(db)
+}
+
+func (qs ChatQuerySet) Select(fields ...ChatDBSchemaField) ChatQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *Chat) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *Chat) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) All(ret *[]Chat) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) Delete() error {
+	return qs.db.Delete(Chat{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(Chat{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(Chat{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) GetUpdater() ChatUpdater {
+	return NewChatUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDEq(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDGt(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDGte(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDIn(ID ...int64) ChatQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDLt(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDLte(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDNe(ID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) IDNotIn(ID ...int64) ChatQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// InitiatorIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDEq(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id = ?", initiatorID))
+}
+
+// InitiatorIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDGt(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id > ?", initiatorID))
+}
+
+// InitiatorIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDGte(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id >= ?", initiatorID))
+}
+
+// InitiatorIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDIn(initiatorID ...int64) ChatQuerySet {
+	if len(initiatorID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one initiatorID in InitiatorIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("initiator_id IN (?)", initiatorID))
+}
+
+// InitiatorIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDLt(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id < ?", initiatorID))
+}
+
+// InitiatorIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDLte(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id <= ?", initiatorID))
+}
+
+// InitiatorIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDNe(initiatorID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("initiator_id != ?", initiatorID))
+}
+
+// InitiatorIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) InitiatorIDNotIn(initiatorID ...int64) ChatQuerySet {
+	if len(initiatorID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one initiatorID in InitiatorIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("initiator_id NOT IN (?)", initiatorID))
+}
+
+// LastUpdatedEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedEq(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated = ?", lastUpdated))
+}
+
+// LastUpdatedGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedGt(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated > ?", lastUpdated))
+}
+
+// LastUpdatedGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedGte(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated >= ?", lastUpdated))
+}
+
+// LastUpdatedIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedIsNotNull() ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated IS NOT NULL"))
+}
+
+// LastUpdatedIsNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedIsNull() ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated IS NULL"))
+}
+
+// LastUpdatedLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedLt(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated < ?", lastUpdated))
+}
+
+// LastUpdatedLte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedLte(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated <= ?", lastUpdated))
+}
+
+// LastUpdatedNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) LastUpdatedNe(lastUpdated time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("last_updated != ?", lastUpdated))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) Limit(limit int) ChatQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) Offset(offset int) ChatQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ChatQuerySet) One(ret *Chat) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscByID() ChatQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByInitiatorID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscByInitiatorID() ChatQuerySet {
+	return qs.w(qs.db.Order("initiator_id ASC"))
+}
+
+// OrderAscByLastUpdated is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscByLastUpdated() ChatQuerySet {
+	return qs.w(qs.db.Order("last_updated ASC"))
+}
+
+// OrderAscByProductID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscByProductID() ChatQuerySet {
+	return qs.w(qs.db.Order("product_id ASC"))
+}
+
+// OrderAscBySubscriberID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscBySubscriberID() ChatQuerySet {
+	return qs.w(qs.db.Order("subscriber_id ASC"))
+}
+
+// OrderAscByTS is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderAscByTS() ChatQuerySet {
+	return qs.w(qs.db.Order("ts ASC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescByID() ChatQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByInitiatorID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescByInitiatorID() ChatQuerySet {
+	return qs.w(qs.db.Order("initiator_id DESC"))
+}
+
+// OrderDescByLastUpdated is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescByLastUpdated() ChatQuerySet {
+	return qs.w(qs.db.Order("last_updated DESC"))
+}
+
+// OrderDescByProductID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescByProductID() ChatQuerySet {
+	return qs.w(qs.db.Order("product_id DESC"))
+}
+
+// OrderDescBySubscriberID is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescBySubscriberID() ChatQuerySet {
+	return qs.w(qs.db.Order("subscriber_id DESC"))
+}
+
+// OrderDescByTS is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) OrderDescByTS() ChatQuerySet {
+	return qs.w(qs.db.Order("ts DESC"))
+}
+
+// ProductIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDEq(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id = ?", productID))
+}
+
+// ProductIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDGt(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id > ?", productID))
+}
+
+// ProductIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDGte(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id >= ?", productID))
+}
+
+// ProductIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDIn(productID ...int64) ChatQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id IN (?)", productID))
+}
+
+// ProductIDIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDIsNotNull() ChatQuerySet {
+	return qs.w(qs.db.Where("product_id IS NOT NULL"))
+}
+
+// ProductIDIsNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDIsNull() ChatQuerySet {
+	return qs.w(qs.db.Where("product_id IS NULL"))
+}
+
+// ProductIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDLt(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id < ?", productID))
+}
+
+// ProductIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDLte(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id <= ?", productID))
+}
+
+// ProductIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDNe(productID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("product_id != ?", productID))
+}
+
+// ProductIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) ProductIDNotIn(productID ...int64) ChatQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
+}
+
+// SubscriberIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDEq(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id = ?", subscriberID))
+}
+
+// SubscriberIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDGt(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id > ?", subscriberID))
+}
+
+// SubscriberIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDGte(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id >= ?", subscriberID))
+}
+
+// SubscriberIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDIn(subscriberID ...int64) ChatQuerySet {
+	if len(subscriberID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one subscriberID in SubscriberIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("subscriber_id IN (?)", subscriberID))
+}
+
+// SubscriberIDLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDLt(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id < ?", subscriberID))
+}
+
+// SubscriberIDLte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDLte(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id <= ?", subscriberID))
+}
+
+// SubscriberIDNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDNe(subscriberID int64) ChatQuerySet {
+	return qs.w(qs.db.Where("subscriber_id != ?", subscriberID))
+}
+
+// SubscriberIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) SubscriberIDNotIn(subscriberID ...int64) ChatQuerySet {
+	if len(subscriberID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one subscriberID in SubscriberIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("subscriber_id NOT IN (?)", subscriberID))
+}
+
+// TSEq is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSEq(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts = ?", tS))
+}
+
+// TSGt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSGt(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts > ?", tS))
+}
+
+// TSGte is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSGte(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts >= ?", tS))
+}
+
+// TSIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSIsNotNull() ChatQuerySet {
+	return qs.w(qs.db.Where("ts IS NOT NULL"))
+}
+
+// TSIsNull is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSIsNull() ChatQuerySet {
+	return qs.w(qs.db.Where("ts IS NULL"))
+}
+
+// TSLt is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSLt(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts < ?", tS))
+}
 
-// InitiatorIDIn is an autogenerated method
+// TSLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDIn(initiatorID ...int64) ChatQuerySet {
-	if len(initiatorID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one initiatorID in InitiatorIDIn"))
+func (qs ChatQuerySet) TSLte(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts <= ?", tS))
+}
+
+// TSNe is an autogenerated method
+// nolint: dupl
+func (qs ChatQuerySet) TSNe(tS time.Time) ChatQuerySet {
+	return qs.w(qs.db.Where("ts != ?", tS))
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetID(ID int64) ChatUpdater {
+	u.fields[string(ChatDBSchema.ID)] = ID
+	return u
+}
+
+// SetInitiatorID is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetInitiatorID(initiatorID int64) ChatUpdater {
+	u.fields[string(ChatDBSchema.InitiatorID)] = initiatorID
+	return u
+}
+
+// SetLastUpdated is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetLastUpdated(lastUpdated *time.Time) ChatUpdater {
+	u.fields[string(ChatDBSchema.LastUpdated)] = lastUpdated
+	return u
+}
+
+// SetProductID is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetProductID(productID *int64) ChatUpdater {
+	u.fields[string(ChatDBSchema.ProductID)] = productID
+	return u
+}
+
+// SetSubscriberID is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetSubscriberID(subscriberID int64) ChatUpdater {
+	u.fields[string(ChatDBSchema.SubscriberID)] = subscriberID
+	return u
+}
+
+// SetTS is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) SetTS(tS *time.Time) ChatUpdater {
+	u.fields[string(ChatDBSchema.TS)] = tS
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ChatUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set ChatQuerySet
+
+// ===== BEGIN of Chat modifiers
+
+// ChatDBSchemaField describes database schema field. It requires for method 'Update'
+type ChatDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ChatDBSchemaField) String() string {
+	return string(f)
+}
+
+// ChatDBSchema stores db field names of Chat
+var ChatDBSchema = struct {
+	ID           ChatDBSchemaField
+	InitiatorID  ChatDBSchemaField
+	SubscriberID ChatDBSchemaField
+	ProductID    ChatDBSchemaField
+	LastUpdated  ChatDBSchemaField
+	TS           ChatDBSchemaField
+}{
+
+	ID:           ChatDBSchemaField("id"),
+	InitiatorID:  ChatDBSchemaField("initiator_id"),
+	SubscriberID: ChatDBSchemaField("subscriber_id"),
+	ProductID:    ChatDBSchemaField("product_id"),
+	LastUpdated:  ChatDBSchemaField("last_updated"),
+	TS:           ChatDBSchemaField("ts"),
+}
+
+// Update updates Chat fields by primary key
+// nolint: dupl
+func (o *Chat) Update(db *gorm.DB, fields ...ChatDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":            o.ID,
+		"initiator_id":  o.InitiatorID,
+		"subscriber_id": o.SubscriberID,
+		"product_id":    o.ProductID,
+		"last_updated":  o.LastUpdated,
+		"ts":            o.TS,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update Chat %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// ChatUpdater is an Chat updates manager
+type ChatUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewChatUpdater creates new Chat updater
+// nolint: dupl
+func NewChatUpdater(db *gorm.DB) ChatUpdater {
+	return ChatUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&Chat{}),
+	}
+}
+
+// ===== END of Chat modifiers
+
+// ===== BEGIN of query set ChatReadQuerySet
+
+// ChatReadQuerySet is an queryset type for ChatRead
+type ChatReadQuerySet struct {
+	db *gorm.DB
+}
+
+// NewChatReadQuerySet constructs new ChatReadQuerySet
+func NewChatReadQuerySet(db *gorm.DB) ChatReadQuerySet {
+	return ChatReadQuerySet{
+		db: db.Model(&ChatRead{}),
+	}
+}
+
+func (qs ChatReadQuerySet) w(db *gorm.DB) ChatReadQuerySet {
+	return NewChatReadQuerySet(db)
+}
+
+func (qs ChatReadQuerySet) Select(fields ...ChatReadDBSchemaField) ChatReadQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *ChatRead) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *ChatRead) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) All(ret *[]ChatRead) error {
+	return qs.db.Find(ret).Error
+}
+
+// ChatIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) ChatIDEq(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id = ?", chatID))
+}
+
+// ChatIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) ChatIDGt(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id > ?", chatID))
+}
+
+// ChatIDGte is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) ChatIDGte(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id >= ?", chatID))
+}
+
+// ChatIDIn is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) ChatIDIn(chatID ...int64) ChatReadQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("initiator_id IN (?)", initiatorID))
+	return qs.w(qs.db.Where("chat_id IN (?)", chatID))
 }
 
-// InitiatorIDLt is an autogenerated method
+// ChatIDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDLt(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id < ?", initiatorID))
+func (qs ChatReadQuerySet) ChatIDLt(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id < ?", chatID))
 }
 
-// InitiatorIDLte is an autogenerated method
+// ChatIDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDLte(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id <= ?", initiatorID))
+func (qs ChatReadQuerySet) ChatIDLte(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id <= ?", chatID))
 }
 
-// InitiatorIDNe is an autogenerated method
+// ChatIDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDNe(initiatorID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("initiator_id != ?", initiatorID))
+func (qs ChatReadQuerySet) ChatIDNe(chatID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("chat_id != ?", chatID))
 }
 
-// InitiatorIDNotIn is an autogenerated method
+// ChatIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) InitiatorIDNotIn(initiatorID ...int64) ChatQuerySet {
-	if len(initiatorID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one initiatorID in InitiatorIDNotIn"))
+func (qs ChatReadQuerySet) ChatIDNotIn(chatID ...int64) ChatReadQuerySet {
+	if len(chatID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one chatID in ChatIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("initiator_id NOT IN (?)", initiatorID))
+	return qs.w(qs.db.Where("chat_id NOT IN (?)", chatID))
 }
 
-// LastUpdatedEq is an autogenerated method
+// Count is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedEq(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated = ?", lastUpdated))
+func (qs ChatReadQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
 }
 
-// LastUpdatedGt is an autogenerated method
+// Delete is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedGt(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated > ?", lastUpdated))
+func (qs ChatReadQuerySet) Delete() error {
+	return qs.db.Delete(ChatRead{}).Error
 }
 
-// LastUpdatedGte is an autogenerated method
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ChatRead{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ChatRead{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) GetUpdater() ChatReadUpdater {
+	return NewChatReadUpdater(qs.db)
+}
+
+// LastReadIDEq is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) LastReadIDEq(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id = ?", lastReadID))
+}
+
+// LastReadIDGt is an autogenerated method
+// nolint: dupl
+func (qs ChatReadQuerySet) LastReadIDGt(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id > ?", lastReadID))
+}
+
+// LastReadIDGte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedGte(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated >= ?", lastUpdated))
+func (qs ChatReadQuerySet) LastReadIDGte(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id >= ?", lastReadID))
 }
 
-// LastUpdatedIsNotNull is an autogenerated method
+// LastReadIDIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedIsNotNull() ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated IS NOT NULL"))
+func (qs ChatReadQuerySet) LastReadIDIn(lastReadID ...int64) ChatReadQuerySet {
+	if len(lastReadID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lastReadID in LastReadIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("last_read_id IN (?)", lastReadID))
 }
 
-// LastUpdatedIsNull is an autogenerated method
+// LastReadIDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedIsNull() ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated IS NULL"))
+func (qs ChatReadQuerySet) LastReadIDLt(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id < ?", lastReadID))
 }
 
-// LastUpdatedLt is an autogenerated method
+// LastReadIDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedLt(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated < ?", lastUpdated))
+func (qs ChatReadQuerySet) LastReadIDLte(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id <= ?", lastReadID))
 }
 
-// LastUpdatedLte is an autogenerated method
+// LastReadIDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedLte(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated <= ?", lastUpdated))
+func (qs ChatReadQuerySet) LastReadIDNe(lastReadID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("last_read_id != ?", lastReadID))
 }
 
-// LastUpdatedNe is an autogenerated method
+// LastReadIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) LastUpdatedNe(lastUpdated time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("last_updated != ?", lastUpdated))
+func (qs ChatReadQuerySet) LastReadIDNotIn(lastReadID ...int64) ChatReadQuerySet {
+	if len(lastReadID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one lastReadID in LastReadIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("last_read_id NOT IN (?)", lastReadID))
 }
 
 // Limit is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) Limit(limit int) ChatQuerySet {
+func (qs ChatReadQuerySet) Limit(limit int) ChatReadQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
 // Offset is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) Offset(offset int) ChatQuerySet {
+func (qs ChatReadQuerySet) Offset(offset int) ChatReadQuerySet {
 	return qs.w(qs.db.Offset(offset))
 }
 
 // One is used to retrieve one result. It returns gorm.ErrRecordNotFound
 // if nothing was fetched
-func (qs ChatQuerySet) One(ret *Chat) error {
+func (qs ChatReadQuerySet) One(ret *ChatRead) error {
 	return qs.db.First(ret).Error
 }
 
-// OrderAscByID is an autogenerated method
-// nolint: dupl
-func (qs ChatQuerySet) OrderAscByID() ChatQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
-}
-
-// OrderAscByInitiatorID is an autogenerated method
-// nolint: dupl
-func (qs ChatQuerySet) OrderAscByInitiatorID() ChatQuerySet {
-	return qs.w(qs.db.Order("initiator_id ASC"))
-}
-
-// OrderAscByLastUpdated is an autogenerated method
-// nolint: dupl
-func (qs ChatQuerySet) OrderAscByLastUpdated() ChatQuerySet {
-	return qs.w(qs.db.Order("last_updated ASC"))
-}
-
-// OrderAscBySubscriberID is an autogenerated method
+// OrderAscByChatID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderAscBySubscriberID() ChatQuerySet {
-	return qs.w(qs.db.Order("subscriber_id ASC"))
+func (qs ChatReadQuerySet) OrderAscByChatID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("chat_id ASC"))
 }
 
-// OrderAscByTS is an autogenerated method
+// OrderAscByLastReadID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderAscByTS() ChatQuerySet {
-	return qs.w(qs.db.Order("ts ASC"))
+func (qs ChatReadQuerySet) OrderAscByLastReadID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("last_read_id ASC"))
 }
 
-// OrderDescByID is an autogenerated method
+// OrderAscByReadAT is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderDescByID() ChatQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs ChatReadQuerySet) OrderAscByReadAT() ChatReadQuerySet {
+	return qs.w(qs.db.Order("read_at ASC"))
 }
 
-// OrderDescByInitiatorID is an autogenerated method
+// OrderAscByUserID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderDescByInitiatorID() ChatQuerySet {
-	return qs.w(qs.db.Order("initiator_id DESC"))
+func (qs ChatReadQuerySet) OrderAscByUserID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
 }
 
-// OrderDescByLastUpdated is an autogenerated method
+// OrderDescByChatID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderDescByLastUpdated() ChatQuerySet {
-	return qs.w(qs.db.Order("last_updated DESC"))
+func (qs ChatReadQuerySet) OrderDescByChatID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("chat_id DESC"))
 }
 
-// OrderDescBySubscriberID is an autogenerated method
+// OrderDescByLastReadID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderDescBySubscriberID() ChatQuerySet {
-	return qs.w(qs.db.Order("subscriber_id DESC"))
+func (qs ChatReadQuerySet) OrderDescByLastReadID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("last_read_id DESC"))
 }
 
-// OrderDescByTS is an autogenerated method
+// OrderDescByReadAT is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) OrderDescByTS() ChatQuerySet {
-	return qs.w(qs.db.Order("ts DESC"))
+func (qs ChatReadQuerySet) OrderDescByReadAT() ChatReadQuerySet {
+	return qs.w(qs.db.Order("read_at DESC"))
 }
 
-// SubscriberIDEq is an autogenerated method
+// OrderDescByUserID is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDEq(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id = ?", subscriberID))
+func (qs ChatReadQuerySet) OrderDescByUserID() ChatReadQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
 }
 
-// SubscriberIDGt is an autogenerated method
+// ReadATEq is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDGt(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id > ?", subscriberID))
+func (qs ChatReadQuerySet) ReadATEq(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at = ?", readAT))
 }
 
-// SubscriberIDGte is an autogenerated method
+// ReadATGt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDGte(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id >= ?", subscriberID))
+func (qs ChatReadQuerySet) ReadATGt(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at > ?", readAT))
 }
 
-// SubscriberIDIn is an autogenerated method
+// ReadATGte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDIn(subscriberID ...int64) ChatQuerySet {
-	if len(subscriberID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one subscriberID in SubscriberIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("subscriber_id IN (?)", subscriberID))
+func (qs ChatReadQuerySet) ReadATGte(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at >= ?", readAT))
 }
 
-// SubscriberIDLt is an autogenerated method
+// ReadATIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDLt(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id < ?", subscriberID))
+func (qs ChatReadQuerySet) ReadATIsNotNull() ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at IS NOT NULL"))
 }
 
-// SubscriberIDLte is an autogenerated method
+// ReadATIsNull is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDLte(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id <= ?", subscriberID))
+func (qs ChatReadQuerySet) ReadATIsNull() ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at IS NULL"))
 }
 
-// SubscriberIDNe is an autogenerated method
+// ReadATLt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDNe(subscriberID int64) ChatQuerySet {
-	return qs.w(qs.db.Where("subscriber_id != ?", subscriberID))
+func (qs ChatReadQuerySet) ReadATLt(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at < ?", readAT))
 }
 
-// SubscriberIDNotIn is an autogenerated method
+// ReadATLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) SubscriberIDNotIn(subscriberID ...int64) ChatQuerySet {
-	if len(subscriberID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one subscriberID in SubscriberIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("subscriber_id NOT IN (?)", subscriberID))
+func (qs ChatReadQuerySet) ReadATLte(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at <= ?", readAT))
 }
 
-// TSEq is an autogenerated method
+// ReadATNe is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSEq(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts = ?", tS))
+func (qs ChatReadQuerySet) ReadATNe(readAT time.Time) ChatReadQuerySet {
+	return qs.w(qs.db.Where("read_at != ?", readAT))
 }
 
-// TSGt is an autogenerated method
+// UserIDEq is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSGt(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts > ?", tS))
+func (qs ChatReadQuerySet) UserIDEq(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
 }
 
-// TSGte is an autogenerated method
+// UserIDGt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSGte(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts >= ?", tS))
+func (qs ChatReadQuerySet) UserIDGt(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
 }
 
-// TSIsNotNull is an autogenerated method
+// UserIDGte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSIsNotNull() ChatQuerySet {
-	return qs.w(qs.db.Where("ts IS NOT NULL"))
+func (qs ChatReadQuerySet) UserIDGte(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
 }
 
-// TSIsNull is an autogenerated method
+// UserIDIn is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSIsNull() ChatQuerySet {
-	return qs.w(qs.db.Where("ts IS NULL"))
+func (qs ChatReadQuerySet) UserIDIn(userID ...int64) ChatReadQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
 }
 
-// TSLt is an autogenerated method
+// UserIDLt is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSLt(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts < ?", tS))
+func (qs ChatReadQuerySet) UserIDLt(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
 }
 
-// TSLte is an autogenerated method
+// UserIDLte is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSLte(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts <= ?", tS))
+func (qs ChatReadQuerySet) UserIDLte(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
 }
 
-// TSNe is an autogenerated method
+// UserIDNe is an autogenerated method
 // nolint: dupl
-func (qs ChatQuerySet) TSNe(tS time.Time) ChatQuerySet {
-	return qs.w(qs.db.Where("ts != ?", tS))
+func (qs ChatReadQuerySet) UserIDNe(userID int64) ChatReadQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
 }
 
-// SetID is an autogenerated method
+// UserIDNotIn is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) SetID(ID int64) ChatUpdater {
-	u.fields[string(ChatDBSchema.ID)] = ID
-	return u
+func (qs ChatReadQuerySet) UserIDNotIn(userID ...int64) ChatReadQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
 }
 
-// SetInitiatorID is an autogenerated method
+// SetChatID is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) SetInitiatorID(initiatorID int64) ChatUpdater {
-	u.fields[string(ChatDBSchema.InitiatorID)] = initiatorID
+func (u ChatReadUpdater) SetChatID(chatID int64) ChatReadUpdater {
+	u.fields[string(ChatReadDBSchema.ChatID)] = chatID
 	return u
 }
 
-// SetLastUpdated is an autogenerated method
+// SetLastReadID is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) SetLastUpdated(lastUpdated *time.Time) ChatUpdater {
-	u.fields[string(ChatDBSchema.LastUpdated)] = lastUpdated
+func (u ChatReadUpdater) SetLastReadID(lastReadID int64) ChatReadUpdater {
+	u.fields[string(ChatReadDBSchema.LastReadID)] = lastReadID
 	return u
 }
 
-// SetSubscriberID is an autogenerated method
+// SetReadAT is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) SetSubscriberID(subscriberID int64) ChatUpdater {
-	u.fields[string(ChatDBSchema.SubscriberID)] = subscriberID
+func (u ChatReadUpdater) SetReadAT(readAT *time.Time) ChatReadUpdater {
+	u.fields[string(ChatReadDBSchema.ReadAT)] = readAT
 	return u
 }
 
-// SetTS is an autogenerated method
+// SetUserID is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) SetTS(tS *time.Time) ChatUpdater {
-	u.fields[string(ChatDBSchema.TS)] = tS
+func (u ChatReadUpdater) SetUserID(userID int64) ChatReadUpdater {
+	u.fields[string(ChatReadDBSchema.UserID)] = userID
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) Update() error {
+func (u ChatReadUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u ChatUpdater) UpdateNum() (int64, error) {
+func (u ChatReadUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set ChatQuerySet
+// ===== END of query set ChatReadQuerySet
 
-// ===== BEGIN of Chat modifiers
+// ===== BEGIN of ChatRead modifiers
 
-// ChatDBSchemaField describes database schema field. It requires for method 'Update'
-type ChatDBSchemaField string
+// ChatReadDBSchemaField describes database schema field. It requires for method 'Update'
+type ChatReadDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f ChatDBSchemaField) String() string {
+func (f ChatReadDBSchemaField) String() string {
 	return string(f)
 }
 
-// ChatDBSchema stores db field names of Chat
-var ChatDBSchema = struct {
-	ID           ChatDBSchemaField
-	InitiatorID  ChatDBSchemaField
-	SubscriberID ChatDBSchemaField
-	LastUpdated  ChatDBSchemaField
-	TS           ChatDBSchemaField
+// ChatReadDBSchema stores db field names of ChatRead
+var ChatReadDBSchema = struct {
+	ChatID     ChatReadDBSchemaField
+	UserID     ChatReadDBSchemaField
+	LastReadID ChatReadDBSchemaField
+	ReadAT     ChatReadDBSchemaField
 }{
 
-	ID:           ChatDBSchemaField("id"),
-	InitiatorID:  ChatDBSchemaField("initiator_id"),
-	SubscriberID: ChatDBSchemaField("subscriber_id"),
-	LastUpdated:  ChatDBSchemaField("last_updated"),
-	TS:           ChatDBSchemaField("ts"),
+	ChatID:     ChatReadDBSchemaField("chat_id"),
+	UserID:     ChatReadDBSchemaField("user_id"),
+	LastReadID: ChatReadDBSchemaField("last_read_id"),
+	ReadAT:     ChatReadDBSchemaField("read_at"),
 }
 
-// Update updates Chat fields by primary key
+// Update updates ChatRead fields by primary key
 // nolint: dupl
-func (o *Chat) Update(db *gorm.DB, fields ...ChatDBSchemaField) error {
+func (o *ChatRead) Update(db *gorm.DB, fields ...ChatReadDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":            o.ID,
-		"initiator_id":  o.InitiatorID,
-		"subscriber_id": o.SubscriberID,
-		"last_updated":  o.LastUpdated,
-		"ts":            o.TS,
+		"chat_id":      o.ChatID,
+		"user_id":      o.UserID,
+		"last_read_id": o.LastReadID,
+		"read_at":      o.ReadAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -1022,29 +2894,29 @@ func (o *Chat) Update(db *gorm.DB, fields ...ChatDBSchemaField) error {
 			return err
 		}
 
-		return fmt.Errorf("can't update Chat %v fields %v: %s",
+		return fmt.Errorf("can't update ChatRead %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// ChatUpdater is an Chat updates manager
-type ChatUpdater struct {
+// ChatReadUpdater is an ChatRead updates manager
+type ChatReadUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewChatUpdater creates new Chat updater
+// NewChatReadUpdater creates new ChatRead updater
 // nolint: dupl
-func NewChatUpdater(db *gorm.DB) ChatUpdater {
-	return ChatUpdater{
+func NewChatReadUpdater(db *gorm.DB) ChatReadUpdater {
+	return ChatReadUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&Chat{}),
+		db:     db.Model(&ChatRead{}),
 	}
 }
 
-// ===== END of Chat modifiers
+// ===== END of ChatRead modifiers
 
 // ===== BEGIN of query set MessageQuerySet
 
@@ -1159,6 +3031,74 @@ func (qs MessageQuerySet) AttachmentDataNotlike(attachmentData string) MessageQu
 	return qs.w(qs.db.Where("attachment_data NOT LIKE ?", attachmentData))
 }
 
+// AttachmentIDEq is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDEq(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id = ?", attachmentID))
+}
+
+// AttachmentIDGt is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDGt(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id > ?", attachmentID))
+}
+
+// AttachmentIDGte is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDGte(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id >= ?", attachmentID))
+}
+
+// AttachmentIDIn is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDIn(attachmentID ...int64) MessageQuerySet {
+	if len(attachmentID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one attachmentID in AttachmentIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("attachment_id IN (?)", attachmentID))
+}
+
+// AttachmentIDIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDIsNotNull() MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id IS NOT NULL"))
+}
+
+// AttachmentIDIsNull is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDIsNull() MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id IS NULL"))
+}
+
+// AttachmentIDLt is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDLt(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id < ?", attachmentID))
+}
+
+// AttachmentIDLte is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDLte(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id <= ?", attachmentID))
+}
+
+// AttachmentIDNe is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDNe(attachmentID int64) MessageQuerySet {
+	return qs.w(qs.db.Where("attachment_id != ?", attachmentID))
+}
+
+// AttachmentIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) AttachmentIDNotIn(attachmentID ...int64) MessageQuerySet {
+	if len(attachmentID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one attachmentID in AttachmentIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("attachment_id NOT IN (?)", attachmentID))
+}
+
 // AttachmentKindEq is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) AttachmentKindEq(attachmentKind int) MessageQuerySet {
@@ -1331,6 +3271,54 @@ func (qs MessageQuerySet) DeletedNotIn(deleted ...bool) MessageQuerySet {
 	return qs.w(qs.db.Where("deleted NOT IN (?)", deleted))
 }
 
+// EditedATEq is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATEq(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at = ?", editedAT))
+}
+
+// EditedATGt is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATGt(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at > ?", editedAT))
+}
+
+// EditedATGte is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATGte(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at >= ?", editedAT))
+}
+
+// EditedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATIsNotNull() MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at IS NOT NULL"))
+}
+
+// EditedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATIsNull() MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at IS NULL"))
+}
+
+// EditedATLt is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATLt(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at < ?", editedAT))
+}
+
+// EditedATLte is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATLte(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at <= ?", editedAT))
+}
+
+// EditedATNe is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) EditedATNe(editedAT time.Time) MessageQuerySet {
+	return qs.w(qs.db.Where("edited_at != ?", editedAT))
+}
+
 // GetDB is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) GetDB() *gorm.DB {
@@ -1423,6 +3411,12 @@ func (qs MessageQuerySet) OrderAscByAttachmentData() MessageQuerySet {
 	return qs.w(qs.db.Order("attachment_data ASC"))
 }
 
+// OrderAscByAttachmentID is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) OrderAscByAttachmentID() MessageQuerySet {
+	return qs.w(qs.db.Order("attachment_id ASC"))
+}
+
 // OrderAscByAttachmentKind is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) OrderAscByAttachmentKind() MessageQuerySet {
@@ -1441,6 +3435,12 @@ func (qs MessageQuerySet) OrderAscByDeleted() MessageQuerySet {
 	return qs.w(qs.db.Order("deleted ASC"))
 }
 
+// OrderAscByEditedAT is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) OrderAscByEditedAT() MessageQuerySet {
+	return qs.w(qs.db.Order("edited_at ASC"))
+}
+
 // OrderAscByID is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) OrderAscByID() MessageQuerySet {
@@ -1477,6 +3477,12 @@ func (qs MessageQuerySet) OrderDescByAttachmentData() MessageQuerySet {
 	return qs.w(qs.db.Order("attachment_data DESC"))
 }
 
+// OrderDescByAttachmentID is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) OrderDescByAttachmentID() MessageQuerySet {
+	return qs.w(qs.db.Order("attachment_id DESC"))
+}
+
 // OrderDescByAttachmentKind is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) OrderDescByAttachmentKind() MessageQuerySet {
@@ -1495,6 +3501,12 @@ func (qs MessageQuerySet) OrderDescByDeleted() MessageQuerySet {
 	return qs.w(qs.db.Order("deleted DESC"))
 }
 
+// OrderDescByEditedAT is an autogenerated method
+// nolint: dupl
+func (qs MessageQuerySet) OrderDescByEditedAT() MessageQuerySet {
+	return qs.w(qs.db.Order("edited_at DESC"))
+}
+
 // OrderDescByID is an autogenerated method
 // nolint: dupl
 func (qs MessageQuerySet) OrderDescByID() MessageQuerySet {
@@ -1760,6 +3772,13 @@ func (u MessageUpdater) SetAttachmentData(attachmentData string) MessageUpdater
 	return u
 }
 
+// SetAttachmentID is an autogenerated method
+// nolint: dupl
+func (u MessageUpdater) SetAttachmentID(attachmentID *int64) MessageUpdater {
+	u.fields[string(MessageDBSchema.AttachmentID)] = attachmentID
+	return u
+}
+
 // SetAttachmentKind is an autogenerated method
 // nolint: dupl
 func (u MessageUpdater) SetAttachmentKind(attachmentKind int) MessageUpdater {
@@ -1781,6 +3800,13 @@ func (u MessageUpdater) SetDeleted(deleted bool) MessageUpdater {
 	return u
 }
 
+// SetEditedAT is an autogenerated method
+// nolint: dupl
+func (u MessageUpdater) SetEditedAT(editedAT *time.Time) MessageUpdater {
+	u.fields[string(MessageDBSchema.EditedAT)] = editedAT
+	return u
+}
+
 // SetID is an autogenerated method
 // nolint: dupl
 func (u MessageUpdater) SetID(ID int64) MessageUpdater {
@@ -1852,7 +3878,9 @@ var MessageDBSchema = struct {
 	Deleted        MessageDBSchemaField
 	AttachmentKind MessageDBSchemaField
 	AttachmentData MessageDBSchemaField
+	AttachmentID   MessageDBSchemaField
 	TS             MessageDBSchemaField
+	EditedAT       MessageDBSchemaField
 }{
 
 	ID:             MessageDBSchemaField("id"),
@@ -1863,7 +3891,9 @@ var MessageDBSchema = struct {
 	Deleted:        MessageDBSchemaField("deleted"),
 	AttachmentKind: MessageDBSchemaField("attachment_kind"),
 	AttachmentData: MessageDBSchemaField("attachment_data"),
+	AttachmentID:   MessageDBSchemaField("attachment_id"),
 	TS:             MessageDBSchemaField("ts"),
+	EditedAT:       MessageDBSchemaField("edited_at"),
 }
 
 // Update updates Message fields by primary key
@@ -1878,7 +3908,9 @@ func (o *Message) Update(db *gorm.DB, fields ...MessageDBSchemaField) error {
 		"deleted":         o.Deleted,
 		"attachment_kind": o.AttachmentKind,
 		"attachment_data": o.AttachmentData,
+		"attachment_id":   o.AttachmentID,
 		"ts":              o.TS,
+		"edited_at":       o.EditedAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
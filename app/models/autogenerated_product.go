@@ -12,25 +12,25 @@ import (
 
 // ===== BEGIN of all query sets
 
-// ===== BEGIN of query set ProductBidderQuerySet
+// ===== BEGIN of query set ImageDuplicateFlagQuerySet
 
-// ProductBidderQuerySet is an queryset type for ProductBidder
-type ProductBidderQuerySet struct {
+// ImageDuplicateFlagQuerySet is an queryset type for ImageDuplicateFlag
+type ImageDuplicateFlagQuerySet struct {
 	db *gorm.DB
 }
 
-// NewProductBidderQuerySet constructs new ProductBidderQuerySet
-func NewProductBidderQuerySet(db *gorm.DB) ProductBidderQuerySet {
-	return ProductBidderQuerySet{
-		db: db.Model(&ProductBidder{}),
+// NewImageDuplicateFlagQuerySet constructs new ImageDuplicateFlagQuerySet
+func NewImageDuplicateFlagQuerySet(db *gorm.DB) ImageDuplicateFlagQuerySet {
+	return ImageDuplicateFlagQuerySet{
+		db: db.Model(&ImageDuplicateFlag{}),
 	}
 }
 
-func (qs ProductBidderQuerySet) w(db *gorm.DB) ProductBidderQuerySet {
-	return NewProductBidderQuerySet(db)
+func (qs ImageDuplicateFlagQuerySet) w(db *gorm.DB) ImageDuplicateFlagQuerySet {
+	return NewImageDuplicateFlagQuerySet(db)
 }
 
-func (qs ProductBidderQuerySet) Select(fields ...ProductBidderDBSchemaField) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) Select(fields ...ImageDuplicateFlagDBSchemaField) ImageDuplicateFlagQuerySet {
 	names := []string{}
 	for _, f := range fields {
 		names = append(names, f.String())
@@ -41,81 +41,25 @@ func (qs ProductBidderQuerySet) Select(fields ...ProductBidderDBSchemaField) Pro
 
 // Create is an autogenerated method
 // nolint: dupl
-func (o *ProductBidder) Create(db *gorm.DB) error {
+func (o *ImageDuplicateFlag) Create(db *gorm.DB) error {
 	return db.Create(o).Error
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (o *ProductBidder) Delete(db *gorm.DB) error {
+func (o *ImageDuplicateFlag) Delete(db *gorm.DB) error {
 	return db.Delete(o).Error
 }
 
 // All is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) All(ret *[]ProductBidder) error {
+func (qs ImageDuplicateFlagQuerySet) All(ret *[]ImageDuplicateFlag) error {
 	return qs.db.Find(ret).Error
 }
 
-// BidPriceEq is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceEq(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price = ?", bidPrice))
-}
-
-// BidPriceGt is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceGt(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price > ?", bidPrice))
-}
-
-// BidPriceGte is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceGte(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price >= ?", bidPrice))
-}
-
-// BidPriceIn is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceIn(bidPrice ...float64) ProductBidderQuerySet {
-	if len(bidPrice) == 0 {
-		qs.db.AddError(errors.New("must at least pass one bidPrice in BidPriceIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("bid_price IN (?)", bidPrice))
-}
-
-// BidPriceLt is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceLt(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price < ?", bidPrice))
-}
-
-// BidPriceLte is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceLte(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price <= ?", bidPrice))
-}
-
-// BidPriceNe is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceNe(bidPrice float64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("bid_price != ?", bidPrice))
-}
-
-// BidPriceNotIn is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) BidPriceNotIn(bidPrice ...float64) ProductBidderQuerySet {
-	if len(bidPrice) == 0 {
-		qs.db.AddError(errors.New("must at least pass one bidPrice in BidPriceNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("bid_price NOT IN (?)", bidPrice))
-}
-
 // Count is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) Count() (int, error) {
+func (qs ImageDuplicateFlagQuerySet) Count() (int, error) {
 	var count int
 	err := qs.db.Count(&count).Error
 	return count, err
@@ -123,105 +67,161 @@ func (qs ProductBidderQuerySet) Count() (int, error) {
 
 // CreatedATEq is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATEq(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATEq(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at = ?", createdAT))
 }
 
 // CreatedATGt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATGt(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATGt(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at > ?", createdAT))
 }
 
 // CreatedATGte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATGte(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATGte(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at >= ?", createdAT))
 }
 
 // CreatedATIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATIsNotNull() ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATIsNotNull() ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at IS NOT NULL"))
 }
 
 // CreatedATIsNull is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATIsNull() ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATIsNull() ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at IS NULL"))
 }
 
 // CreatedATLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATLt(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATLt(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at < ?", createdAT))
 }
 
 // CreatedATLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATLte(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATLte(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at <= ?", createdAT))
 }
 
 // CreatedATNe is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) CreatedATNe(createdAT time.Time) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) CreatedATNe(createdAT time.Time) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("created_at != ?", createdAT))
 }
 
 // Delete is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) Delete() error {
-	return qs.db.Delete(ProductBidder{}).Error
+func (qs ImageDuplicateFlagQuerySet) Delete() error {
+	return qs.db.Delete(ImageDuplicateFlag{}).Error
 }
 
 // DeleteNum is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(ProductBidder{})
+func (qs ImageDuplicateFlagQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ImageDuplicateFlag{})
 	return db.RowsAffected, db.Error
 }
 
 // DeleteNumUnscoped is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(ProductBidder{})
+func (qs ImageDuplicateFlagQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ImageDuplicateFlag{})
 	return db.RowsAffected, db.Error
 }
 
+// DistanceEq is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceEq(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance = ?", distance))
+}
+
+// DistanceGt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceGt(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance > ?", distance))
+}
+
+// DistanceGte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceGte(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance >= ?", distance))
+}
+
+// DistanceIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceIn(distance ...int) ImageDuplicateFlagQuerySet {
+	if len(distance) == 0 {
+		qs.db.AddError(errors.New("must at least pass one distance in DistanceIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("distance IN (?)", distance))
+}
+
+// DistanceLt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceLt(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance < ?", distance))
+}
+
+// DistanceLte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceLte(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance <= ?", distance))
+}
+
+// DistanceNe is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceNe(distance int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("distance != ?", distance))
+}
+
+// DistanceNotIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) DistanceNotIn(distance ...int) ImageDuplicateFlagQuerySet {
+	if len(distance) == 0 {
+		qs.db.AddError(errors.New("must at least pass one distance in DistanceNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("distance NOT IN (?)", distance))
+}
+
 // GetDB is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) GetDB() *gorm.DB {
+func (qs ImageDuplicateFlagQuerySet) GetDB() *gorm.DB {
 	return qs.db
 }
 
 // GetUpdater is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) GetUpdater() ProductBidderUpdater {
-	return NewProductBidderUpdater(qs.db)
+func (qs ImageDuplicateFlagQuerySet) GetUpdater() ImageDuplicateFlagUpdater {
+	return NewImageDuplicateFlagUpdater(qs.db)
 }
 
 // IDEq is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDEq(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDEq(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id = ?", ID))
 }
 
 // IDGt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDGt(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDGt(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id > ?", ID))
 }
 
 // IDGte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDGte(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDGte(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id >= ?", ID))
 }
 
 // IDIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDIn(ID ...int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDIn(ID ...int64) ImageDuplicateFlagQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
 		return qs.w(qs.db)
@@ -231,25 +231,25 @@ func (qs ProductBidderQuerySet) IDIn(ID ...int64) ProductBidderQuerySet {
 
 // IDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDLt(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDLt(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id < ?", ID))
 }
 
 // IDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDLte(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDLte(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id <= ?", ID))
 }
 
 // IDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDNe(ID int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDNe(ID int64) ImageDuplicateFlagQuerySet {
 	return qs.w(qs.db.Where("id != ?", ID))
 }
 
 // IDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) IDNotIn(ID ...int64) ProductBidderQuerySet {
+func (qs ImageDuplicateFlagQuerySet) IDNotIn(ID ...int64) ImageDuplicateFlagQuerySet {
 	if len(ID) == 0 {
 		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
 		return qs.w(qs.db)
@@ -257,357 +257,598 @@ func (qs ProductBidderQuerySet) IDNotIn(ID ...int64) ProductBidderQuerySet {
 	return qs.w(qs.db.Where("id NOT IN (?)", ID))
 }
 
-// Limit is an autogenerated method
+// ImageIDEq is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) Limit(limit int) ProductBidderQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs ImageDuplicateFlagQuerySet) ImageIDEq(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id = ?", imageID))
 }
 
-// Offset is an autogenerated method
+// ImageIDGt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) Offset(offset int) ProductBidderQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs ImageDuplicateFlagQuerySet) ImageIDGt(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id > ?", imageID))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs ProductBidderQuerySet) One(ret *ProductBidder) error {
-	return qs.db.First(ret).Error
+// ImageIDGte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ImageIDGte(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id >= ?", imageID))
 }
 
-// OrderAscByBidPrice is an autogenerated method
+// ImageIDIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByBidPrice() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("bid_price ASC"))
+func (qs ImageDuplicateFlagQuerySet) ImageIDIn(imageID ...int64) ImageDuplicateFlagQuerySet {
+	if len(imageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one imageID in ImageIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("image_id IN (?)", imageID))
 }
 
-// OrderAscByCreatedAT is an autogenerated method
+// ImageIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByCreatedAT() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("created_at ASC"))
+func (qs ImageDuplicateFlagQuerySet) ImageIDLt(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id < ?", imageID))
 }
 
-// OrderAscByID is an autogenerated method
+// ImageIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs ImageDuplicateFlagQuerySet) ImageIDLte(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id <= ?", imageID))
 }
 
-// OrderAscByProductID is an autogenerated method
+// ImageIDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByProductID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("product_id ASC"))
+func (qs ImageDuplicateFlagQuerySet) ImageIDNe(imageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("image_id != ?", imageID))
 }
 
-// OrderAscByUserID is an autogenerated method
+// ImageIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByUserID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("user_id ASC"))
+func (qs ImageDuplicateFlagQuerySet) ImageIDNotIn(imageID ...int64) ImageDuplicateFlagQuerySet {
+	if len(imageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one imageID in ImageIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("image_id NOT IN (?)", imageID))
 }
 
-// OrderAscByWinner is an autogenerated method
+// Limit is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderAscByWinner() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("winner ASC"))
+func (qs ImageDuplicateFlagQuerySet) Limit(limit int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Limit(limit))
 }
 
-// OrderDescByBidPrice is an autogenerated method
+// MatchedImageIDEq is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByBidPrice() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("bid_price DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDEq(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id = ?", matchedImageID))
 }
 
-// OrderDescByCreatedAT is an autogenerated method
+// MatchedImageIDGt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByCreatedAT() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("created_at DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDGt(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id > ?", matchedImageID))
 }
 
-// OrderDescByID is an autogenerated method
+// MatchedImageIDGte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDGte(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id >= ?", matchedImageID))
 }
 
-// OrderDescByProductID is an autogenerated method
+// MatchedImageIDIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByProductID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("product_id DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDIn(matchedImageID ...int64) ImageDuplicateFlagQuerySet {
+	if len(matchedImageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one matchedImageID in MatchedImageIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("matched_image_id IN (?)", matchedImageID))
 }
 
-// OrderDescByUserID is an autogenerated method
+// MatchedImageIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByUserID() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("user_id DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDLt(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id < ?", matchedImageID))
 }
 
-// OrderDescByWinner is an autogenerated method
+// MatchedImageIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) OrderDescByWinner() ProductBidderQuerySet {
-	return qs.w(qs.db.Order("winner DESC"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDLte(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id <= ?", matchedImageID))
 }
 
-// PreloadUser is an autogenerated method
+// MatchedImageIDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) PreloadUser() ProductBidderQuerySet {
-	return qs.w(qs.db.Preload("User"))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDNe(matchedImageID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_image_id != ?", matchedImageID))
 }
 
-// ProductIDEq is an autogenerated method
+// MatchedImageIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDEq(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id = ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedImageIDNotIn(matchedImageID ...int64) ImageDuplicateFlagQuerySet {
+	if len(matchedImageID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one matchedImageID in MatchedImageIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("matched_image_id NOT IN (?)", matchedImageID))
 }
 
-// ProductIDGt is an autogenerated method
+// MatchedProductIDEq is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDGt(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id > ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDEq(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id = ?", matchedProductID))
 }
 
-// ProductIDGte is an autogenerated method
+// MatchedProductIDGt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDGte(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id >= ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDGt(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id > ?", matchedProductID))
 }
 
-// ProductIDIn is an autogenerated method
+// MatchedProductIDGte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDIn(productID ...int64) ProductBidderQuerySet {
-	if len(productID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDGte(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id >= ?", matchedProductID))
+}
+
+// MatchedProductIDIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDIn(matchedProductID ...int64) ImageDuplicateFlagQuerySet {
+	if len(matchedProductID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one matchedProductID in MatchedProductIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("product_id IN (?)", productID))
+	return qs.w(qs.db.Where("matched_product_id IN (?)", matchedProductID))
 }
 
-// ProductIDLt is an autogenerated method
+// MatchedProductIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDLt(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id < ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDLt(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id < ?", matchedProductID))
 }
 
-// ProductIDLte is an autogenerated method
+// MatchedProductIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDLte(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id <= ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDLte(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id <= ?", matchedProductID))
 }
 
-// ProductIDNe is an autogenerated method
+// MatchedProductIDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDNe(productID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("product_id != ?", productID))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDNe(matchedProductID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("matched_product_id != ?", matchedProductID))
 }
 
-// ProductIDNotIn is an autogenerated method
+// MatchedProductIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) ProductIDNotIn(productID ...int64) ProductBidderQuerySet {
-	if len(productID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+func (qs ImageDuplicateFlagQuerySet) MatchedProductIDNotIn(matchedProductID ...int64) ImageDuplicateFlagQuerySet {
+	if len(matchedProductID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one matchedProductID in MatchedProductIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
+	return qs.w(qs.db.Where("matched_product_id NOT IN (?)", matchedProductID))
 }
 
-// UserIDEq is an autogenerated method
+// Offset is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDEq(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id = ?", userID))
+func (qs ImageDuplicateFlagQuerySet) Offset(offset int) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Offset(offset))
 }
 
-// UserIDGt is an autogenerated method
-// nolint: dupl
-func (qs ProductBidderQuerySet) UserIDGt(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id > ?", userID))
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ImageDuplicateFlagQuerySet) One(ret *ImageDuplicateFlag) error {
+	return qs.db.First(ret).Error
 }
 
-// UserIDGte is an autogenerated method
+// OrderAscByCreatedAT is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDGte(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id >= ?", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByCreatedAT() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
 }
 
-// UserIDIn is an autogenerated method
+// OrderAscByDistance is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDIn(userID ...int64) ProductBidderQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_id IN (?)", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByDistance() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("distance ASC"))
 }
 
-// UserIDLt is an autogenerated method
+// OrderAscByID is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDLt(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id < ?", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
 }
 
-// UserIDLte is an autogenerated method
+// OrderAscByImageID is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDLte(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id <= ?", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByImageID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("image_id ASC"))
 }
 
-// UserIDNe is an autogenerated method
+// OrderAscByMatchedImageID is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDNe(userID int64) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user_id != ?", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByMatchedImageID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("matched_image_id ASC"))
 }
 
-// UserIDNotIn is an autogenerated method
+// OrderAscByMatchedProductID is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIDNotIn(userID ...int64) ProductBidderQuerySet {
-	if len(userID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
-		return qs.w(qs.db)
-	}
-	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByMatchedProductID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("matched_product_id ASC"))
 }
 
-// UserIsNotNull is an autogenerated method
+// OrderAscByProductID is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIsNotNull() ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user IS NOT NULL"))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByProductID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("product_id ASC"))
 }
 
-// UserIsNull is an autogenerated method
+// OrderAscByResolvedAT is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) UserIsNull() ProductBidderQuerySet {
-	return qs.w(qs.db.Where("user IS NULL"))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByResolvedAT() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("resolved_at ASC"))
 }
 
-// WinnerEq is an autogenerated method
+// OrderAscByResolvedBy is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) WinnerEq(winner bool) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("winner = ?", winner))
+func (qs ImageDuplicateFlagQuerySet) OrderAscByResolvedBy() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("resolved_by ASC"))
 }
 
-// WinnerIn is an autogenerated method
+// OrderDescByCreatedAT is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) WinnerIn(winner ...bool) ProductBidderQuerySet {
-	if len(winner) == 0 {
-		qs.db.AddError(errors.New("must at least pass one winner in WinnerIn"))
+func (qs ImageDuplicateFlagQuerySet) OrderDescByCreatedAT() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByDistance is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByDistance() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("distance DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByImageID is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByImageID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("image_id DESC"))
+}
+
+// OrderDescByMatchedImageID is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByMatchedImageID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("matched_image_id DESC"))
+}
+
+// OrderDescByMatchedProductID is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByMatchedProductID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("matched_product_id DESC"))
+}
+
+// OrderDescByProductID is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByProductID() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("product_id DESC"))
+}
+
+// OrderDescByResolvedAT is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByResolvedAT() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("resolved_at DESC"))
+}
+
+// OrderDescByResolvedBy is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) OrderDescByResolvedBy() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Order("resolved_by DESC"))
+}
+
+// ProductIDEq is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDEq(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id = ?", productID))
+}
+
+// ProductIDGt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDGt(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id > ?", productID))
+}
+
+// ProductIDGte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDGte(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id >= ?", productID))
+}
+
+// ProductIDIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDIn(productID ...int64) ImageDuplicateFlagQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("winner IN (?)", winner))
+	return qs.w(qs.db.Where("product_id IN (?)", productID))
 }
 
-// WinnerNe is an autogenerated method
+// ProductIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) WinnerNe(winner bool) ProductBidderQuerySet {
-	return qs.w(qs.db.Where("winner != ?", winner))
+func (qs ImageDuplicateFlagQuerySet) ProductIDLt(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id < ?", productID))
 }
 
-// WinnerNotIn is an autogenerated method
+// ProductIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductBidderQuerySet) WinnerNotIn(winner ...bool) ProductBidderQuerySet {
-	if len(winner) == 0 {
-		qs.db.AddError(errors.New("must at least pass one winner in WinnerNotIn"))
+func (qs ImageDuplicateFlagQuerySet) ProductIDLte(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id <= ?", productID))
+}
+
+// ProductIDNe is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDNe(productID int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("product_id != ?", productID))
+}
+
+// ProductIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ProductIDNotIn(productID ...int64) ImageDuplicateFlagQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("winner NOT IN (?)", winner))
+	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
 }
 
-// SetBidPrice is an autogenerated method
+// ResolvedATEq is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetBidPrice(bidPrice float64) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.BidPrice)] = bidPrice
-	return u
+func (qs ImageDuplicateFlagQuerySet) ResolvedATEq(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at = ?", resolvedAT))
+}
+
+// ResolvedATGt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATGt(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at > ?", resolvedAT))
+}
+
+// ResolvedATGte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATGte(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at >= ?", resolvedAT))
+}
+
+// ResolvedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATIsNotNull() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at IS NOT NULL"))
+}
+
+// ResolvedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATIsNull() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at IS NULL"))
+}
+
+// ResolvedATLt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATLt(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at < ?", resolvedAT))
+}
+
+// ResolvedATLte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATLte(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at <= ?", resolvedAT))
+}
+
+// ResolvedATNe is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedATNe(resolvedAT time.Time) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_at != ?", resolvedAT))
+}
+
+// ResolvedByEq is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByEq(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by = ?", resolvedBy))
+}
+
+// ResolvedByGt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByGt(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by > ?", resolvedBy))
+}
+
+// ResolvedByGte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByGte(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by >= ?", resolvedBy))
+}
+
+// ResolvedByIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByIn(resolvedBy ...int64) ImageDuplicateFlagQuerySet {
+	if len(resolvedBy) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resolvedBy in ResolvedByIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("resolved_by IN (?)", resolvedBy))
+}
+
+// ResolvedByIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByIsNotNull() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by IS NOT NULL"))
+}
+
+// ResolvedByIsNull is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByIsNull() ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by IS NULL"))
+}
+
+// ResolvedByLt is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByLt(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by < ?", resolvedBy))
+}
+
+// ResolvedByLte is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByLte(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by <= ?", resolvedBy))
+}
+
+// ResolvedByNe is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByNe(resolvedBy int64) ImageDuplicateFlagQuerySet {
+	return qs.w(qs.db.Where("resolved_by != ?", resolvedBy))
+}
+
+// ResolvedByNotIn is an autogenerated method
+// nolint: dupl
+func (qs ImageDuplicateFlagQuerySet) ResolvedByNotIn(resolvedBy ...int64) ImageDuplicateFlagQuerySet {
+	if len(resolvedBy) == 0 {
+		qs.db.AddError(errors.New("must at least pass one resolvedBy in ResolvedByNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("resolved_by NOT IN (?)", resolvedBy))
 }
 
 // SetCreatedAT is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetCreatedAT(createdAT *time.Time) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.CreatedAT)] = createdAT
+func (u ImageDuplicateFlagUpdater) SetCreatedAT(createdAT *time.Time) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// SetDistance is an autogenerated method
+// nolint: dupl
+func (u ImageDuplicateFlagUpdater) SetDistance(distance int) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.Distance)] = distance
 	return u
 }
 
 // SetID is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetID(ID int64) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.ID)] = ID
+func (u ImageDuplicateFlagUpdater) SetID(ID int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.ID)] = ID
+	return u
+}
+
+// SetImageID is an autogenerated method
+// nolint: dupl
+func (u ImageDuplicateFlagUpdater) SetImageID(imageID int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.ImageID)] = imageID
+	return u
+}
+
+// SetMatchedImageID is an autogenerated method
+// nolint: dupl
+func (u ImageDuplicateFlagUpdater) SetMatchedImageID(matchedImageID int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.MatchedImageID)] = matchedImageID
+	return u
+}
+
+// SetMatchedProductID is an autogenerated method
+// nolint: dupl
+func (u ImageDuplicateFlagUpdater) SetMatchedProductID(matchedProductID int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.MatchedProductID)] = matchedProductID
 	return u
 }
 
 // SetProductID is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetProductID(productID int64) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.ProductID)] = productID
+func (u ImageDuplicateFlagUpdater) SetProductID(productID int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.ProductID)] = productID
 	return u
 }
 
-// SetUserID is an autogenerated method
+// SetResolvedAT is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetUserID(userID int64) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.UserID)] = userID
+func (u ImageDuplicateFlagUpdater) SetResolvedAT(resolvedAT *time.Time) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.ResolvedAT)] = resolvedAT
 	return u
 }
 
-// SetWinner is an autogenerated method
+// SetResolvedBy is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) SetWinner(winner bool) ProductBidderUpdater {
-	u.fields[string(ProductBidderDBSchema.Winner)] = winner
+func (u ImageDuplicateFlagUpdater) SetResolvedBy(resolvedBy *int64) ImageDuplicateFlagUpdater {
+	u.fields[string(ImageDuplicateFlagDBSchema.ResolvedBy)] = resolvedBy
 	return u
 }
 
 // Update is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) Update() error {
+func (u ImageDuplicateFlagUpdater) Update() error {
 	return u.db.Updates(u.fields).Error
 }
 
 // UpdateNum is an autogenerated method
 // nolint: dupl
-func (u ProductBidderUpdater) UpdateNum() (int64, error) {
+func (u ImageDuplicateFlagUpdater) UpdateNum() (int64, error) {
 	db := u.db.Updates(u.fields)
 	return db.RowsAffected, db.Error
 }
 
-// ===== END of query set ProductBidderQuerySet
+// ===== END of query set ImageDuplicateFlagQuerySet
 
-// ===== BEGIN of ProductBidder modifiers
+// ===== BEGIN of ImageDuplicateFlag modifiers
 
-// ProductBidderDBSchemaField describes database schema field. It requires for method 'Update'
-type ProductBidderDBSchemaField string
+// ImageDuplicateFlagDBSchemaField describes database schema field. It requires for method 'Update'
+type ImageDuplicateFlagDBSchemaField string
 
 // String method returns string representation of field.
 // nolint: dupl
-func (f ProductBidderDBSchemaField) String() string {
+func (f ImageDuplicateFlagDBSchemaField) String() string {
 	return string(f)
 }
 
-// ProductBidderDBSchema stores db field names of ProductBidder
-var ProductBidderDBSchema = struct {
-	ID        ProductBidderDBSchemaField
-	UserID    ProductBidderDBSchemaField
-	ProductID ProductBidderDBSchemaField
-	BidPrice  ProductBidderDBSchemaField
-	Winner    ProductBidderDBSchemaField
-	CreatedAT ProductBidderDBSchemaField
-	User      ProductBidderDBSchemaField
+// ImageDuplicateFlagDBSchema stores db field names of ImageDuplicateFlag
+var ImageDuplicateFlagDBSchema = struct {
+	ID               ImageDuplicateFlagDBSchemaField
+	ProductID        ImageDuplicateFlagDBSchemaField
+	ImageID          ImageDuplicateFlagDBSchemaField
+	MatchedProductID ImageDuplicateFlagDBSchemaField
+	MatchedImageID   ImageDuplicateFlagDBSchemaField
+	Distance         ImageDuplicateFlagDBSchemaField
+	ResolvedBy       ImageDuplicateFlagDBSchemaField
+	ResolvedAT       ImageDuplicateFlagDBSchemaField
+	CreatedAT        ImageDuplicateFlagDBSchemaField
 }{
 
-	ID:        ProductBidderDBSchemaField("id"),
-	UserID:    ProductBidderDBSchemaField("user_id"),
-	ProductID: ProductBidderDBSchemaField("product_id"),
-	BidPrice:  ProductBidderDBSchemaField("bid_price"),
-	Winner:    ProductBidderDBSchemaField("winner"),
-	CreatedAT: ProductBidderDBSchemaField("created_at"),
-	User:      ProductBidderDBSchemaField("user"),
+	ID:               ImageDuplicateFlagDBSchemaField("id"),
+	ProductID:        ImageDuplicateFlagDBSchemaField("product_id"),
+	ImageID:          ImageDuplicateFlagDBSchemaField("image_id"),
+	MatchedProductID: ImageDuplicateFlagDBSchemaField("matched_product_id"),
+	MatchedImageID:   ImageDuplicateFlagDBSchemaField("matched_image_id"),
+	Distance:         ImageDuplicateFlagDBSchemaField("distance"),
+	ResolvedBy:       ImageDuplicateFlagDBSchemaField("resolved_by"),
+	ResolvedAT:       ImageDuplicateFlagDBSchemaField("resolved_at"),
+	CreatedAT:        ImageDuplicateFlagDBSchemaField("created_at"),
 }
 
-// Update updates ProductBidder fields by primary key
+// Update updates ImageDuplicateFlag fields by primary key
 // nolint: dupl
-func (o *ProductBidder) Update(db *gorm.DB, fields ...ProductBidderDBSchemaField) error {
+func (o *ImageDuplicateFlag) Update(db *gorm.DB, fields ...ImageDuplicateFlagDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"user_id":    o.UserID,
-		"product_id": o.ProductID,
-		"bid_price":  o.BidPrice,
-		"winner":     o.Winner,
-		"created_at": o.CreatedAT,
-		"user":       o.User,
+		"id":                 o.ID,
+		"product_id":         o.ProductID,
+		"image_id":           o.ImageID,
+		"matched_product_id": o.MatchedProductID,
+		"matched_image_id":   o.MatchedImageID,
+		"distance":           o.Distance,
+		"resolved_by":        o.ResolvedBy,
+		"resolved_at":        o.ResolvedAT,
+		"created_at":         o.CreatedAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -619,347 +860,1712 @@ func (o *ProductBidder) Update(db *gorm.DB, fields ...ProductBidderDBSchemaField
 			return err
 		}
 
-		return fmt.Errorf("can't update ProductBidder %v fields %v: %s",
+		return fmt.Errorf("can't update ImageDuplicateFlag %v fields %v: %s",
 			o, fields, err)
 	}
 
 	return nil
 }
 
-// ProductBidderUpdater is an ProductBidder updates manager
-type ProductBidderUpdater struct {
+// ImageDuplicateFlagUpdater is an ImageDuplicateFlag updates manager
+type ImageDuplicateFlagUpdater struct {
 	fields map[string]interface{}
 	db     *gorm.DB
 }
 
-// NewProductBidderUpdater creates new ProductBidder updater
+// NewImageDuplicateFlagUpdater creates new ImageDuplicateFlag updater
 // nolint: dupl
-func NewProductBidderUpdater(db *gorm.DB) ProductBidderUpdater {
-	return ProductBidderUpdater{
+func NewImageDuplicateFlagUpdater(db *gorm.DB) ImageDuplicateFlagUpdater {
+	return ImageDuplicateFlagUpdater{
 		fields: map[string]interface{}{},
-		db:     db.Model(&ProductBidder{}),
+		db:     db.Model(&ImageDuplicateFlag{}),
 	}
 }
 
-// ===== END of ProductBidder modifiers
+// ===== END of ImageDuplicateFlag modifiers
 
-// ===== BEGIN of query set ProductImageQuerySet
+// ===== BEGIN of query set ProductBidderQuerySet
 
-// ProductImageQuerySet is an queryset type for ProductImage
-type ProductImageQuerySet struct {
+// ProductBidderQuerySet is an queryset type for ProductBidder
+type ProductBidderQuerySet struct {
 	db *gorm.DB
 }
 
-// NewProductImageQuerySet constructs new ProductImageQuerySet
-func NewProductImageQuerySet(db *gorm.DB) ProductImageQuerySet {
-	return ProductImageQuerySet{
-		db: db.Model(&ProductImage{}),
-	}
+// NewProductBidderQuerySet constructs new ProductBidderQuerySet
+func NewProductBidderQuerySet(db *gorm.DB) ProductBidderQuerySet {
+	return ProductBidderQuerySet{
+		db: db.Model(&ProductBidder{}),
+	}
+}
+
+func (qs ProductBidderQuerySet) w(db *gorm.DB) ProductBidderQuerySet {
+	return NewProductBidderQuerySet(db)
+}
+
+func (qs ProductBidderQuerySet) Select(fields ...ProductBidderDBSchemaField) ProductBidderQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *ProductBidder) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *ProductBidder) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) All(ret *[]ProductBidder) error {
+	return qs.db.Find(ret).Error
+}
+
+// BidPriceEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceEq(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price = ?", bidPrice))
+}
+
+// BidPriceGt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceGt(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price > ?", bidPrice))
+}
+
+// BidPriceGte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceGte(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price >= ?", bidPrice))
+}
+
+// BidPriceIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceIn(bidPrice ...float64) ProductBidderQuerySet {
+	if len(bidPrice) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bidPrice in BidPriceIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("bid_price IN (?)", bidPrice))
+}
+
+// BidPriceLt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceLt(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price < ?", bidPrice))
+}
+
+// BidPriceLte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceLte(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price <= ?", bidPrice))
+}
+
+// BidPriceNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceNe(bidPrice float64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("bid_price != ?", bidPrice))
+}
+
+// BidPriceNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) BidPriceNotIn(bidPrice ...float64) ProductBidderQuerySet {
+	if len(bidPrice) == 0 {
+		qs.db.AddError(errors.New("must at least pass one bidPrice in BidPriceNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("bid_price NOT IN (?)", bidPrice))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedATEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATEq(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAT))
+}
+
+// CreatedATGt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATGt(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAT))
+}
+
+// CreatedATGte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATGte(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAT))
+}
+
+// CreatedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATIsNotNull() ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at IS NOT NULL"))
+}
+
+// CreatedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATIsNull() ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at IS NULL"))
+}
+
+// CreatedATLt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATLt(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAT))
+}
+
+// CreatedATLte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATLte(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAT))
+}
+
+// CreatedATNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) CreatedATNe(createdAT time.Time) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAT))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) Delete() error {
+	return qs.db.Delete(ProductBidder{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ProductBidder{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ProductBidder{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) GetUpdater() ProductBidderUpdater {
+	return NewProductBidderUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDEq(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDGt(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDGte(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDIn(ID ...int64) ProductBidderQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDLt(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDLte(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDNe(ID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) IDNotIn(ID ...int64) ProductBidderQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) Limit(limit int) ProductBidderQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) Offset(offset int) ProductBidderQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ProductBidderQuerySet) One(ret *ProductBidder) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByBidPrice is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByBidPrice() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("bid_price ASC"))
+}
+
+// OrderAscByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByCreatedAT() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByProductID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByProductID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("product_id ASC"))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByUserID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
+}
+
+// OrderAscByWinner is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderAscByWinner() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("winner ASC"))
+}
+
+// OrderDescByBidPrice is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByBidPrice() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("bid_price DESC"))
+}
+
+// OrderDescByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByCreatedAT() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByProductID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByProductID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("product_id DESC"))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByUserID() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
+}
+
+// OrderDescByWinner is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) OrderDescByWinner() ProductBidderQuerySet {
+	return qs.w(qs.db.Order("winner DESC"))
+}
+
+// PreloadUser is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) PreloadUser() ProductBidderQuerySet {
+	return qs.w(qs.db.Preload("User"))
+}
+
+// ProductIDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDEq(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id = ?", productID))
+}
+
+// ProductIDGt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDGt(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id > ?", productID))
+}
+
+// ProductIDGte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDGte(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id >= ?", productID))
+}
+
+// ProductIDIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDIn(productID ...int64) ProductBidderQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id IN (?)", productID))
+}
+
+// ProductIDLt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDLt(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id < ?", productID))
+}
+
+// ProductIDLte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDLte(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id <= ?", productID))
+}
+
+// ProductIDNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDNe(productID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("product_id != ?", productID))
+}
+
+// ProductIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) ProductIDNotIn(productID ...int64) ProductBidderQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDEq(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDGt(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDGte(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDIn(userID ...int64) ProductBidderQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDLt(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDLte(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDNe(userID int64) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIDNotIn(userID ...int64) ProductBidderQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+}
+
+// UserIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIsNotNull() ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user IS NOT NULL"))
+}
+
+// UserIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) UserIsNull() ProductBidderQuerySet {
+	return qs.w(qs.db.Where("user IS NULL"))
+}
+
+// WinnerEq is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) WinnerEq(winner bool) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("winner = ?", winner))
+}
+
+// WinnerIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) WinnerIn(winner ...bool) ProductBidderQuerySet {
+	if len(winner) == 0 {
+		qs.db.AddError(errors.New("must at least pass one winner in WinnerIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("winner IN (?)", winner))
+}
+
+// WinnerNe is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) WinnerNe(winner bool) ProductBidderQuerySet {
+	return qs.w(qs.db.Where("winner != ?", winner))
+}
+
+// WinnerNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductBidderQuerySet) WinnerNotIn(winner ...bool) ProductBidderQuerySet {
+	if len(winner) == 0 {
+		qs.db.AddError(errors.New("must at least pass one winner in WinnerNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("winner NOT IN (?)", winner))
+}
+
+// SetBidPrice is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetBidPrice(bidPrice float64) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.BidPrice)] = bidPrice
+	return u
+}
+
+// SetCreatedAT is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetCreatedAT(createdAT *time.Time) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetID(ID int64) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.ID)] = ID
+	return u
+}
+
+// SetProductID is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetProductID(productID int64) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.ProductID)] = productID
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetUserID(userID int64) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.UserID)] = userID
+	return u
+}
+
+// SetWinner is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) SetWinner(winner bool) ProductBidderUpdater {
+	u.fields[string(ProductBidderDBSchema.Winner)] = winner
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u ProductBidderUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set ProductBidderQuerySet
+
+// ===== BEGIN of ProductBidder modifiers
+
+// ProductBidderDBSchemaField describes database schema field. It requires for method 'Update'
+type ProductBidderDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f ProductBidderDBSchemaField) String() string {
+	return string(f)
+}
+
+// ProductBidderDBSchema stores db field names of ProductBidder
+var ProductBidderDBSchema = struct {
+	ID        ProductBidderDBSchemaField
+	UserID    ProductBidderDBSchemaField
+	ProductID ProductBidderDBSchemaField
+	BidPrice  ProductBidderDBSchemaField
+	Winner    ProductBidderDBSchemaField
+	CreatedAT ProductBidderDBSchemaField
+	User      ProductBidderDBSchemaField
+}{
+
+	ID:        ProductBidderDBSchemaField("id"),
+	UserID:    ProductBidderDBSchemaField("user_id"),
+	ProductID: ProductBidderDBSchemaField("product_id"),
+	BidPrice:  ProductBidderDBSchemaField("bid_price"),
+	Winner:    ProductBidderDBSchemaField("winner"),
+	CreatedAT: ProductBidderDBSchemaField("created_at"),
+	User:      ProductBidderDBSchemaField("user"),
+}
+
+// Update updates ProductBidder fields by primary key
+// nolint: dupl
+func (o *ProductBidder) Update(db *gorm.DB, fields ...ProductBidderDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"user_id":    o.UserID,
+		"product_id": o.ProductID,
+		"bid_price":  o.BidPrice,
+		"winner":     o.Winner,
+		"created_at": o.CreatedAT,
+		"user":       o.User,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update ProductBidder %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// ProductBidderUpdater is an ProductBidder updates manager
+type ProductBidderUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewProductBidderUpdater creates new ProductBidder updater
+// nolint: dupl
+func NewProductBidderUpdater(db *gorm.DB) ProductBidderUpdater {
+	return ProductBidderUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&ProductBidder{}),
+	}
+}
+
+// ===== END of ProductBidder modifiers
+
+// ===== BEGIN of query set ProductImageQuerySet
+
+// ProductImageQuerySet is an queryset type for ProductImage
+type ProductImageQuerySet struct {
+	db *gorm.DB
+}
+
+// NewProductImageQuerySet constructs new ProductImageQuerySet
+func NewProductImageQuerySet(db *gorm.DB) ProductImageQuerySet {
+	return ProductImageQuerySet{
+		db: db.Model(&ProductImage{}),
+	}
+}
+
+func (qs ProductImageQuerySet) w(db *gorm.DB) ProductImageQuerySet {
+	return NewProductImageQuerySet(db)
+}
+
+func (qs ProductImageQuerySet) Select(fields ...ProductImageDBSchemaField) ProductImageQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *ProductImage) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *ProductImage) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) All(ret *[]ProductImage) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedATEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATEq(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAT))
+}
+
+// CreatedATGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATGt(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAT))
+}
+
+// CreatedATGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATGte(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAT))
+}
+
+// CreatedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATIsNotNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at IS NOT NULL"))
+}
+
+// CreatedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATIsNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at IS NULL"))
+}
+
+// CreatedATLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATLt(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAT))
+}
+
+// CreatedATLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATLte(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAT))
+}
+
+// CreatedATNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) CreatedATNe(createdAT time.Time) ProductImageQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAT))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) Delete() error {
+	return qs.db.Delete(ProductImage{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(ProductImage{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(ProductImage{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) GetUpdater() ProductImageUpdater {
+	return NewProductImageUpdater(qs.db)
+}
+
+// HeightEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightEq(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height = ?", height))
+}
+
+// HeightGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightGt(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height > ?", height))
+}
+
+// HeightGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightGte(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height >= ?", height))
+}
+
+// HeightIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightIn(height ...int) ProductImageQuerySet {
+	if len(height) == 0 {
+		qs.db.AddError(errors.New("must at least pass one height in HeightIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("height IN (?)", height))
+}
+
+// HeightLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightLt(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height < ?", height))
+}
+
+// HeightLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightLte(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height <= ?", height))
+}
+
+// HeightNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightNe(height int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("height != ?", height))
+}
+
+// HeightNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) HeightNotIn(height ...int) ProductImageQuerySet {
+	if len(height) == 0 {
+		qs.db.AddError(errors.New("must at least pass one height in HeightNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("height NOT IN (?)", height))
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDEq(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDGt(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDGte(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDIn(ID ...int64) ProductImageQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDLt(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDLte(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDNe(ID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IDNotIn(ID ...int64) ProductImageQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// ImageURLEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLEq(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url = ?", imageURL))
+}
+
+// ImageURLGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLGt(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url > ?", imageURL))
+}
+
+// ImageURLGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLGte(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url >= ?", imageURL))
+}
+
+// ImageURLIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLIn(imageURL ...string) ProductImageQuerySet {
+	if len(imageURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one imageURL in ImageURLIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("image_url IN (?)", imageURL))
+}
+
+// ImageURLLike is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLLike(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url LIKE ?", imageURL))
+}
+
+// ImageURLLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLLt(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url < ?", imageURL))
+}
+
+// ImageURLLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLLte(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url <= ?", imageURL))
+}
+
+// ImageURLNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLNe(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url != ?", imageURL))
+}
+
+// ImageURLNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLNotIn(imageURL ...string) ProductImageQuerySet {
+	if len(imageURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one imageURL in ImageURLNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("image_url NOT IN (?)", imageURL))
+}
+
+// ImageURLNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ImageURLNotlike(imageURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("image_url NOT LIKE ?", imageURL))
+}
+
+// IsPrimaryEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IsPrimaryEq(isPrimary bool) ProductImageQuerySet {
+	return qs.w(qs.db.Where("is_primary = ?", isPrimary))
+}
+
+// IsPrimaryIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IsPrimaryIn(isPrimary ...bool) ProductImageQuerySet {
+	if len(isPrimary) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isPrimary in IsPrimaryIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_primary IN (?)", isPrimary))
+}
+
+// IsPrimaryNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IsPrimaryNe(isPrimary bool) ProductImageQuerySet {
+	return qs.w(qs.db.Where("is_primary != ?", isPrimary))
+}
+
+// IsPrimaryNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) IsPrimaryNotIn(isPrimary ...bool) ProductImageQuerySet {
+	if len(isPrimary) == 0 {
+		qs.db.AddError(errors.New("must at least pass one isPrimary in IsPrimaryNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("is_primary NOT IN (?)", isPrimary))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) Limit(limit int) ProductImageQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// MediumURLEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLEq(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url = ?", mediumURL))
+}
+
+// MediumURLGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLGt(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url > ?", mediumURL))
+}
+
+// MediumURLGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLGte(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url >= ?", mediumURL))
+}
+
+// MediumURLIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLIn(mediumURL ...string) ProductImageQuerySet {
+	if len(mediumURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one mediumURL in MediumURLIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("medium_url IN (?)", mediumURL))
+}
+
+// MediumURLLike is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLLike(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url LIKE ?", mediumURL))
+}
+
+// MediumURLLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLLt(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url < ?", mediumURL))
+}
+
+// MediumURLLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLLte(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url <= ?", mediumURL))
+}
+
+// MediumURLNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLNe(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url != ?", mediumURL))
+}
+
+// MediumURLNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLNotIn(mediumURL ...string) ProductImageQuerySet {
+	if len(mediumURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one mediumURL in MediumURLNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("medium_url NOT IN (?)", mediumURL))
+}
+
+// MediumURLNotlike is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) MediumURLNotlike(mediumURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("medium_url NOT LIKE ?", mediumURL))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) Offset(offset int) ProductImageQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs ProductImageQuerySet) One(ret *ProductImage) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByCreatedAT() ProductImageQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByHeight is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByHeight() ProductImageQuerySet {
+	return qs.w(qs.db.Order("height ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByImageURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByImageURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("image_url ASC"))
+}
+
+// OrderAscByIsPrimary is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByIsPrimary() ProductImageQuerySet {
+	return qs.w(qs.db.Order("is_primary ASC"))
+}
+
+// OrderAscByMediumURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByMediumURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("medium_url ASC"))
+}
+
+// OrderAscByPhash is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByPhash() ProductImageQuerySet {
+	return qs.w(qs.db.Order("phash ASC"))
+}
+
+// OrderAscByPosition is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByPosition() ProductImageQuerySet {
+	return qs.w(qs.db.Order("position ASC"))
+}
+
+// OrderAscByProductID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByProductID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("product_id ASC"))
+}
+
+// OrderAscByStorageKey is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByStorageKey() ProductImageQuerySet {
+	return qs.w(qs.db.Order("storage_key ASC"))
+}
+
+// OrderAscByThumbURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByThumbURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("thumb_url ASC"))
+}
+
+// OrderAscByUploaderID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByUploaderID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("uploader_id ASC"))
+}
+
+// OrderAscByWidth is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderAscByWidth() ProductImageQuerySet {
+	return qs.w(qs.db.Order("width ASC"))
+}
+
+// OrderDescByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByCreatedAT() ProductImageQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByHeight is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByHeight() ProductImageQuerySet {
+	return qs.w(qs.db.Order("height DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByImageURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByImageURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("image_url DESC"))
+}
+
+// OrderDescByIsPrimary is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByIsPrimary() ProductImageQuerySet {
+	return qs.w(qs.db.Order("is_primary DESC"))
+}
+
+// OrderDescByMediumURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByMediumURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("medium_url DESC"))
+}
+
+// OrderDescByPhash is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByPhash() ProductImageQuerySet {
+	return qs.w(qs.db.Order("phash DESC"))
+}
+
+// OrderDescByPosition is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByPosition() ProductImageQuerySet {
+	return qs.w(qs.db.Order("position DESC"))
+}
+
+// OrderDescByProductID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByProductID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("product_id DESC"))
+}
+
+// OrderDescByStorageKey is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByStorageKey() ProductImageQuerySet {
+	return qs.w(qs.db.Order("storage_key DESC"))
+}
+
+// OrderDescByThumbURL is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByThumbURL() ProductImageQuerySet {
+	return qs.w(qs.db.Order("thumb_url DESC"))
+}
+
+// OrderDescByUploaderID is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByUploaderID() ProductImageQuerySet {
+	return qs.w(qs.db.Order("uploader_id DESC"))
+}
+
+// OrderDescByWidth is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) OrderDescByWidth() ProductImageQuerySet {
+	return qs.w(qs.db.Order("width DESC"))
+}
+
+// PhashEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashEq(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash = ?", phash))
+}
+
+// PhashGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashGt(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash > ?", phash))
+}
+
+// PhashGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashGte(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash >= ?", phash))
+}
+
+// PhashIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashIn(phash ...int64) ProductImageQuerySet {
+	if len(phash) == 0 {
+		qs.db.AddError(errors.New("must at least pass one phash in PhashIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("phash IN (?)", phash))
+}
+
+// PhashIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashIsNotNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash IS NOT NULL"))
+}
+
+// PhashIsNull is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashIsNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash IS NULL"))
+}
+
+// PhashLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashLt(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash < ?", phash))
+}
+
+// PhashLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashLte(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash <= ?", phash))
+}
+
+// PhashNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashNe(phash int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("phash != ?", phash))
+}
+
+// PhashNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PhashNotIn(phash ...int64) ProductImageQuerySet {
+	if len(phash) == 0 {
+		qs.db.AddError(errors.New("must at least pass one phash in PhashNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("phash NOT IN (?)", phash))
+}
+
+// PositionEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionEq(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position = ?", position))
+}
+
+// PositionGt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionGt(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position > ?", position))
+}
+
+// PositionGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionGte(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position >= ?", position))
+}
+
+// PositionIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionIn(position ...int) ProductImageQuerySet {
+	if len(position) == 0 {
+		qs.db.AddError(errors.New("must at least pass one position in PositionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("position IN (?)", position))
+}
+
+// PositionLt is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionLt(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position < ?", position))
+}
+
+// PositionLte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionLte(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position <= ?", position))
 }
 
-func (qs ProductImageQuerySet) w(db *gorm.DB) ProductImageQuerySet {
-	return NewProductImageQuerySet(db)
+// PositionNe is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionNe(position int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("position != ?", position))
 }
 
-func (qs ProductImageQuerySet) Select(fields ...ProductImageDBSchemaField) ProductImageQuerySet {
-	names := []string{}
-	for _, f := range fields {
-		names = append(names, f.String())
+// PositionNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) PositionNotIn(position ...int) ProductImageQuerySet {
+	if len(position) == 0 {
+		qs.db.AddError(errors.New("must at least pass one position in PositionNotIn"))
+		return qs.w(qs.db)
 	}
+	return qs.w(qs.db.Where("position NOT IN (?)", position))
+}
 
-	return qs.w(qs.db.Select(strings.Join(names, ",")))
+// ProductIDEq is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ProductIDEq(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id = ?", productID))
 }
 
-// Create is an autogenerated method
+// ProductIDGt is an autogenerated method
 // nolint: dupl
-func (o *ProductImage) Create(db *gorm.DB) error {
-	return db.Create(o).Error
+func (qs ProductImageQuerySet) ProductIDGt(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id > ?", productID))
 }
 
-// Delete is an autogenerated method
+// ProductIDGte is an autogenerated method
 // nolint: dupl
-func (o *ProductImage) Delete(db *gorm.DB) error {
-	return db.Delete(o).Error
+func (qs ProductImageQuerySet) ProductIDGte(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id >= ?", productID))
 }
 
-// All is an autogenerated method
+// ProductIDIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) All(ret *[]ProductImage) error {
-	return qs.db.Find(ret).Error
+func (qs ProductImageQuerySet) ProductIDIn(productID ...int64) ProductImageQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id IN (?)", productID))
 }
 
-// Count is an autogenerated method
+// ProductIDIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) Count() (int, error) {
-	var count int
-	err := qs.db.Count(&count).Error
-	return count, err
+func (qs ProductImageQuerySet) ProductIDIsNotNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id IS NOT NULL"))
 }
 
-// Delete is an autogenerated method
+// ProductIDIsNull is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) Delete() error {
-	return qs.db.Delete(ProductImage{}).Error
+func (qs ProductImageQuerySet) ProductIDIsNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id IS NULL"))
 }
 
-// DeleteNum is an autogenerated method
+// ProductIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) DeleteNum() (int64, error) {
-	db := qs.db.Delete(ProductImage{})
-	return db.RowsAffected, db.Error
+func (qs ProductImageQuerySet) ProductIDLt(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id < ?", productID))
 }
 
-// DeleteNumUnscoped is an autogenerated method
+// ProductIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) DeleteNumUnscoped() (int64, error) {
-	db := qs.db.Unscoped().Delete(ProductImage{})
-	return db.RowsAffected, db.Error
+func (qs ProductImageQuerySet) ProductIDLte(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id <= ?", productID))
 }
 
-// GetDB is an autogenerated method
+// ProductIDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) GetDB() *gorm.DB {
-	return qs.db
+func (qs ProductImageQuerySet) ProductIDNe(productID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("product_id != ?", productID))
 }
 
-// GetUpdater is an autogenerated method
+// ProductIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) GetUpdater() ProductImageUpdater {
-	return NewProductImageUpdater(qs.db)
+func (qs ProductImageQuerySet) ProductIDNotIn(productID ...int64) ProductImageQuerySet {
+	if len(productID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
 }
 
-// IDEq is an autogenerated method
+// StorageKeyEq is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDEq(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id = ?", ID))
+func (qs ProductImageQuerySet) StorageKeyEq(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key = ?", storageKey))
 }
 
-// IDGt is an autogenerated method
+// StorageKeyGt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDGt(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id > ?", ID))
+func (qs ProductImageQuerySet) StorageKeyGt(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key > ?", storageKey))
 }
 
-// IDGte is an autogenerated method
+// StorageKeyGte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDGte(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id >= ?", ID))
+func (qs ProductImageQuerySet) StorageKeyGte(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key >= ?", storageKey))
 }
 
-// IDIn is an autogenerated method
+// StorageKeyIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDIn(ID ...int64) ProductImageQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+func (qs ProductImageQuerySet) StorageKeyIn(storageKey ...string) ProductImageQuerySet {
+	if len(storageKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one storageKey in StorageKeyIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id IN (?)", ID))
+	return qs.w(qs.db.Where("storage_key IN (?)", storageKey))
 }
 
-// IDLt is an autogenerated method
+// StorageKeyLike is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDLt(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id < ?", ID))
+func (qs ProductImageQuerySet) StorageKeyLike(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key LIKE ?", storageKey))
 }
 
-// IDLte is an autogenerated method
+// StorageKeyLt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDLte(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id <= ?", ID))
+func (qs ProductImageQuerySet) StorageKeyLt(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key < ?", storageKey))
 }
 
-// IDNe is an autogenerated method
+// StorageKeyLte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDNe(ID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("id != ?", ID))
+func (qs ProductImageQuerySet) StorageKeyLte(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key <= ?", storageKey))
 }
 
-// IDNotIn is an autogenerated method
+// StorageKeyNe is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) IDNotIn(ID ...int64) ProductImageQuerySet {
-	if len(ID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+func (qs ProductImageQuerySet) StorageKeyNe(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key != ?", storageKey))
+}
+
+// StorageKeyNotIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) StorageKeyNotIn(storageKey ...string) ProductImageQuerySet {
+	if len(storageKey) == 0 {
+		qs.db.AddError(errors.New("must at least pass one storageKey in StorageKeyNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+	return qs.w(qs.db.Where("storage_key NOT IN (?)", storageKey))
 }
 
-// ImageURLEq is an autogenerated method
+// StorageKeyNotlike is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLEq(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url = ?", imageURL))
+func (qs ProductImageQuerySet) StorageKeyNotlike(storageKey string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("storage_key NOT LIKE ?", storageKey))
 }
 
-// ImageURLGt is an autogenerated method
+// ThumbURLEq is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLGt(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url > ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLEq(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url = ?", thumbURL))
 }
 
-// ImageURLGte is an autogenerated method
+// ThumbURLGt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLGte(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url >= ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLGt(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url > ?", thumbURL))
 }
 
-// ImageURLIn is an autogenerated method
+// ThumbURLGte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLIn(imageURL ...string) ProductImageQuerySet {
-	if len(imageURL) == 0 {
-		qs.db.AddError(errors.New("must at least pass one imageURL in ImageURLIn"))
+func (qs ProductImageQuerySet) ThumbURLGte(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url >= ?", thumbURL))
+}
+
+// ThumbURLIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) ThumbURLIn(thumbURL ...string) ProductImageQuerySet {
+	if len(thumbURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one thumbURL in ThumbURLIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("image_url IN (?)", imageURL))
+	return qs.w(qs.db.Where("thumb_url IN (?)", thumbURL))
 }
 
-// ImageURLLike is an autogenerated method
+// ThumbURLLike is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLLike(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url LIKE ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLLike(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url LIKE ?", thumbURL))
 }
 
-// ImageURLLt is an autogenerated method
+// ThumbURLLt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLLt(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url < ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLLt(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url < ?", thumbURL))
 }
 
-// ImageURLLte is an autogenerated method
+// ThumbURLLte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLLte(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url <= ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLLte(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url <= ?", thumbURL))
 }
 
-// ImageURLNe is an autogenerated method
+// ThumbURLNe is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLNe(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url != ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLNe(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url != ?", thumbURL))
 }
 
-// ImageURLNotIn is an autogenerated method
+// ThumbURLNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLNotIn(imageURL ...string) ProductImageQuerySet {
-	if len(imageURL) == 0 {
-		qs.db.AddError(errors.New("must at least pass one imageURL in ImageURLNotIn"))
+func (qs ProductImageQuerySet) ThumbURLNotIn(thumbURL ...string) ProductImageQuerySet {
+	if len(thumbURL) == 0 {
+		qs.db.AddError(errors.New("must at least pass one thumbURL in ThumbURLNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("image_url NOT IN (?)", imageURL))
+	return qs.w(qs.db.Where("thumb_url NOT IN (?)", thumbURL))
 }
 
-// ImageURLNotlike is an autogenerated method
+// ThumbURLNotlike is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ImageURLNotlike(imageURL string) ProductImageQuerySet {
-	return qs.w(qs.db.Where("image_url NOT LIKE ?", imageURL))
+func (qs ProductImageQuerySet) ThumbURLNotlike(thumbURL string) ProductImageQuerySet {
+	return qs.w(qs.db.Where("thumb_url NOT LIKE ?", thumbURL))
 }
 
-// Limit is an autogenerated method
+// UploaderIDEq is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) Limit(limit int) ProductImageQuerySet {
-	return qs.w(qs.db.Limit(limit))
+func (qs ProductImageQuerySet) UploaderIDEq(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id = ?", uploaderID))
 }
 
-// Offset is an autogenerated method
+// UploaderIDGt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) Offset(offset int) ProductImageQuerySet {
-	return qs.w(qs.db.Offset(offset))
+func (qs ProductImageQuerySet) UploaderIDGt(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id > ?", uploaderID))
 }
 
-// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
-// if nothing was fetched
-func (qs ProductImageQuerySet) One(ret *ProductImage) error {
-	return qs.db.First(ret).Error
+// UploaderIDGte is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) UploaderIDGte(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id >= ?", uploaderID))
 }
 
-// OrderAscByID is an autogenerated method
+// UploaderIDIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderAscByID() ProductImageQuerySet {
-	return qs.w(qs.db.Order("id ASC"))
+func (qs ProductImageQuerySet) UploaderIDIn(uploaderID ...int64) ProductImageQuerySet {
+	if len(uploaderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one uploaderID in UploaderIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("uploader_id IN (?)", uploaderID))
 }
 
-// OrderAscByImageURL is an autogenerated method
+// UploaderIDIsNotNull is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderAscByImageURL() ProductImageQuerySet {
-	return qs.w(qs.db.Order("image_url ASC"))
+func (qs ProductImageQuerySet) UploaderIDIsNotNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id IS NOT NULL"))
 }
 
-// OrderAscByProductID is an autogenerated method
+// UploaderIDIsNull is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderAscByProductID() ProductImageQuerySet {
-	return qs.w(qs.db.Order("product_id ASC"))
+func (qs ProductImageQuerySet) UploaderIDIsNull() ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id IS NULL"))
 }
 
-// OrderDescByID is an autogenerated method
+// UploaderIDLt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderDescByID() ProductImageQuerySet {
-	return qs.w(qs.db.Order("id DESC"))
+func (qs ProductImageQuerySet) UploaderIDLt(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id < ?", uploaderID))
 }
 
-// OrderDescByImageURL is an autogenerated method
+// UploaderIDLte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderDescByImageURL() ProductImageQuerySet {
-	return qs.w(qs.db.Order("image_url DESC"))
+func (qs ProductImageQuerySet) UploaderIDLte(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id <= ?", uploaderID))
 }
 
-// OrderDescByProductID is an autogenerated method
+// UploaderIDNe is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) OrderDescByProductID() ProductImageQuerySet {
-	return qs.w(qs.db.Order("product_id DESC"))
+func (qs ProductImageQuerySet) UploaderIDNe(uploaderID int64) ProductImageQuerySet {
+	return qs.w(qs.db.Where("uploader_id != ?", uploaderID))
 }
 
-// ProductIDEq is an autogenerated method
+// UploaderIDNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDEq(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id = ?", productID))
+func (qs ProductImageQuerySet) UploaderIDNotIn(uploaderID ...int64) ProductImageQuerySet {
+	if len(uploaderID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one uploaderID in UploaderIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("uploader_id NOT IN (?)", uploaderID))
 }
 
-// ProductIDGt is an autogenerated method
+// WidthEq is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDGt(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id > ?", productID))
+func (qs ProductImageQuerySet) WidthEq(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width = ?", width))
 }
 
-// ProductIDGte is an autogenerated method
+// WidthGt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDGte(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id >= ?", productID))
+func (qs ProductImageQuerySet) WidthGt(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width > ?", width))
 }
 
-// ProductIDIn is an autogenerated method
+// WidthGte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDIn(productID ...int64) ProductImageQuerySet {
-	if len(productID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one productID in ProductIDIn"))
+func (qs ProductImageQuerySet) WidthGte(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width >= ?", width))
+}
+
+// WidthIn is an autogenerated method
+// nolint: dupl
+func (qs ProductImageQuerySet) WidthIn(width ...int) ProductImageQuerySet {
+	if len(width) == 0 {
+		qs.db.AddError(errors.New("must at least pass one width in WidthIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("product_id IN (?)", productID))
+	return qs.w(qs.db.Where("width IN (?)", width))
 }
 
-// ProductIDLt is an autogenerated method
+// WidthLt is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDLt(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id < ?", productID))
+func (qs ProductImageQuerySet) WidthLt(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width < ?", width))
 }
 
-// ProductIDLte is an autogenerated method
+// WidthLte is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDLte(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id <= ?", productID))
+func (qs ProductImageQuerySet) WidthLte(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width <= ?", width))
 }
 
-// ProductIDNe is an autogenerated method
+// WidthNe is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDNe(productID int64) ProductImageQuerySet {
-	return qs.w(qs.db.Where("product_id != ?", productID))
+func (qs ProductImageQuerySet) WidthNe(width int) ProductImageQuerySet {
+	return qs.w(qs.db.Where("width != ?", width))
 }
 
-// ProductIDNotIn is an autogenerated method
+// WidthNotIn is an autogenerated method
 // nolint: dupl
-func (qs ProductImageQuerySet) ProductIDNotIn(productID ...int64) ProductImageQuerySet {
-	if len(productID) == 0 {
-		qs.db.AddError(errors.New("must at least pass one productID in ProductIDNotIn"))
+func (qs ProductImageQuerySet) WidthNotIn(width ...int) ProductImageQuerySet {
+	if len(width) == 0 {
+		qs.db.AddError(errors.New("must at least pass one width in WidthNotIn"))
 		return qs.w(qs.db)
 	}
-	return qs.w(qs.db.Where("product_id NOT IN (?)", productID))
+	return qs.w(qs.db.Where("width NOT IN (?)", width))
+}
+
+// SetCreatedAT is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetCreatedAT(createdAT *time.Time) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// SetHeight is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetHeight(height int) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.Height)] = height
+	return u
 }
 
 // SetID is an autogenerated method
@@ -976,13 +2582,69 @@ func (u ProductImageUpdater) SetImageURL(imageURL string) ProductImageUpdater {
 	return u
 }
 
+// SetIsPrimary is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetIsPrimary(isPrimary bool) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.IsPrimary)] = isPrimary
+	return u
+}
+
+// SetMediumURL is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetMediumURL(mediumURL string) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.MediumURL)] = mediumURL
+	return u
+}
+
+// SetPhash is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetPhash(phash *int64) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.Phash)] = phash
+	return u
+}
+
+// SetPosition is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetPosition(position int) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.Position)] = position
+	return u
+}
+
 // SetProductID is an autogenerated method
 // nolint: dupl
-func (u ProductImageUpdater) SetProductID(productID int64) ProductImageUpdater {
+func (u ProductImageUpdater) SetProductID(productID *int64) ProductImageUpdater {
 	u.fields[string(ProductImageDBSchema.ProductID)] = productID
 	return u
 }
 
+// SetStorageKey is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetStorageKey(storageKey string) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.StorageKey)] = storageKey
+	return u
+}
+
+// SetThumbURL is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetThumbURL(thumbURL string) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.ThumbURL)] = thumbURL
+	return u
+}
+
+// SetUploaderID is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetUploaderID(uploaderID *int64) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.UploaderID)] = uploaderID
+	return u
+}
+
+// SetWidth is an autogenerated method
+// nolint: dupl
+func (u ProductImageUpdater) SetWidth(width int) ProductImageUpdater {
+	u.fields[string(ProductImageDBSchema.Width)] = width
+	return u
+}
+
 // Update is an autogenerated method
 // nolint: dupl
 func (u ProductImageUpdater) Update() error {
@@ -1011,23 +2673,53 @@ func (f ProductImageDBSchemaField) String() string {
 
 // ProductImageDBSchema stores db field names of ProductImage
 var ProductImageDBSchema = struct {
-	ID        ProductImageDBSchemaField
-	ProductID ProductImageDBSchemaField
-	ImageURL  ProductImageDBSchemaField
+	ID         ProductImageDBSchemaField
+	ProductID  ProductImageDBSchemaField
+	UploaderID ProductImageDBSchemaField
+	StorageKey ProductImageDBSchemaField
+	ImageURL   ProductImageDBSchemaField
+	ThumbURL   ProductImageDBSchemaField
+	MediumURL  ProductImageDBSchemaField
+	Width      ProductImageDBSchemaField
+	Height     ProductImageDBSchemaField
+	Position   ProductImageDBSchemaField
+	IsPrimary  ProductImageDBSchemaField
+	CreatedAT  ProductImageDBSchemaField
+	Phash      ProductImageDBSchemaField
 }{
 
-	ID:        ProductImageDBSchemaField("id"),
-	ProductID: ProductImageDBSchemaField("product_id"),
-	ImageURL:  ProductImageDBSchemaField("image_url"),
+	ID:         ProductImageDBSchemaField("id"),
+	ProductID:  ProductImageDBSchemaField("product_id"),
+	UploaderID: ProductImageDBSchemaField("uploader_id"),
+	StorageKey: ProductImageDBSchemaField("storage_key"),
+	ImageURL:   ProductImageDBSchemaField("image_url"),
+	ThumbURL:   ProductImageDBSchemaField("thumb_url"),
+	MediumURL:  ProductImageDBSchemaField("medium_url"),
+	Width:      ProductImageDBSchemaField("width"),
+	Height:     ProductImageDBSchemaField("height"),
+	Position:   ProductImageDBSchemaField("position"),
+	IsPrimary:  ProductImageDBSchemaField("is_primary"),
+	CreatedAT:  ProductImageDBSchemaField("created_at"),
+	Phash:      ProductImageDBSchemaField("phash"),
 }
 
 // Update updates ProductImage fields by primary key
 // nolint: dupl
 func (o *ProductImage) Update(db *gorm.DB, fields ...ProductImageDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
-		"id":         o.ID,
-		"product_id": o.ProductID,
-		"image_url":  o.ImageURL,
+		"id":          o.ID,
+		"product_id":  o.ProductID,
+		"uploader_id": o.UploaderID,
+		"storage_key": o.StorageKey,
+		"image_url":   o.ImageURL,
+		"thumb_url":   o.ThumbURL,
+		"medium_url":  o.MediumURL,
+		"width":       o.Width,
+		"height":      o.Height,
+		"position":    o.Position,
+		"is_primary":  o.IsPrimary,
+		"created_at":  o.CreatedAT,
+		"phash":       o.Phash,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -2441,6 +2441,406 @@ func NewStoreUpdater(db *gorm.DB) StoreUpdater {
 
 // ===== END of Store modifiers
 
+// ===== BEGIN of query set UserBlockQuerySet
+
+// UserBlockQuerySet is an queryset type for UserBlock
+type UserBlockQuerySet struct {
+	db *gorm.DB
+}
+
+// NewUserBlockQuerySet constructs new UserBlockQuerySet
+func NewUserBlockQuerySet(db *gorm.DB) UserBlockQuerySet {
+	return UserBlockQuerySet{
+		db: db.Model(&UserBlock{}),
+	}
+}
+
+func (qs UserBlockQuerySet) w(db *gorm.DB) UserBlockQuerySet {
+	return NewUserBlockQuerySet(db)
+}
+
+func (qs UserBlockQuerySet) Select(fields ...UserBlockDBSchemaField) UserBlockQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *UserBlock) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *UserBlock) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) All(ret *[]UserBlock) error {
+	return qs.db.Find(ret).Error
+}
+
+// BlockedIDEq is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDEq(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id = ?", blockedID))
+}
+
+// BlockedIDGt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDGt(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id > ?", blockedID))
+}
+
+// BlockedIDGte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDGte(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id >= ?", blockedID))
+}
+
+// BlockedIDIn is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDIn(blockedID ...int64) UserBlockQuerySet {
+	if len(blockedID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blockedID in BlockedIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blocked_id IN (?)", blockedID))
+}
+
+// BlockedIDLt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDLt(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id < ?", blockedID))
+}
+
+// BlockedIDLte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDLte(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id <= ?", blockedID))
+}
+
+// BlockedIDNe is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDNe(blockedID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocked_id != ?", blockedID))
+}
+
+// BlockedIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockedIDNotIn(blockedID ...int64) UserBlockQuerySet {
+	if len(blockedID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blockedID in BlockedIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blocked_id NOT IN (?)", blockedID))
+}
+
+// BlockerIDEq is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDEq(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id = ?", blockerID))
+}
+
+// BlockerIDGt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDGt(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id > ?", blockerID))
+}
+
+// BlockerIDGte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDGte(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id >= ?", blockerID))
+}
+
+// BlockerIDIn is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDIn(blockerID ...int64) UserBlockQuerySet {
+	if len(blockerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blockerID in BlockerIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blocker_id IN (?)", blockerID))
+}
+
+// BlockerIDLt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDLt(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id < ?", blockerID))
+}
+
+// BlockerIDLte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDLte(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id <= ?", blockerID))
+}
+
+// BlockerIDNe is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDNe(blockerID int64) UserBlockQuerySet {
+	return qs.w(qs.db.Where("blocker_id != ?", blockerID))
+}
+
+// BlockerIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) BlockerIDNotIn(blockerID ...int64) UserBlockQuerySet {
+	if len(blockerID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one blockerID in BlockerIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("blocker_id NOT IN (?)", blockerID))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedATEq is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATEq(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAT))
+}
+
+// CreatedATGt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATGt(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAT))
+}
+
+// CreatedATGte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATGte(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAT))
+}
+
+// CreatedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATIsNotNull() UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at IS NOT NULL"))
+}
+
+// CreatedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATIsNull() UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at IS NULL"))
+}
+
+// CreatedATLt is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATLt(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAT))
+}
+
+// CreatedATLte is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATLte(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAT))
+}
+
+// CreatedATNe is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) CreatedATNe(createdAT time.Time) UserBlockQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAT))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) Delete() error {
+	return qs.db.Delete(UserBlock{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(UserBlock{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(UserBlock{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) GetUpdater() UserBlockUpdater {
+	return NewUserBlockUpdater(qs.db)
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) Limit(limit int) UserBlockQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) Offset(offset int) UserBlockQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs UserBlockQuerySet) One(ret *UserBlock) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByBlockedID is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderAscByBlockedID() UserBlockQuerySet {
+	return qs.w(qs.db.Order("blocked_id ASC"))
+}
+
+// OrderAscByBlockerID is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderAscByBlockerID() UserBlockQuerySet {
+	return qs.w(qs.db.Order("blocker_id ASC"))
+}
+
+// OrderAscByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderAscByCreatedAT() UserBlockQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderDescByBlockedID is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderDescByBlockedID() UserBlockQuerySet {
+	return qs.w(qs.db.Order("blocked_id DESC"))
+}
+
+// OrderDescByBlockerID is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderDescByBlockerID() UserBlockQuerySet {
+	return qs.w(qs.db.Order("blocker_id DESC"))
+}
+
+// OrderDescByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs UserBlockQuerySet) OrderDescByCreatedAT() UserBlockQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// SetBlockedID is an autogenerated method
+// nolint: dupl
+func (u UserBlockUpdater) SetBlockedID(blockedID int64) UserBlockUpdater {
+	u.fields[string(UserBlockDBSchema.BlockedID)] = blockedID
+	return u
+}
+
+// SetBlockerID is an autogenerated method
+// nolint: dupl
+func (u UserBlockUpdater) SetBlockerID(blockerID int64) UserBlockUpdater {
+	u.fields[string(UserBlockDBSchema.BlockerID)] = blockerID
+	return u
+}
+
+// SetCreatedAT is an autogenerated method
+// nolint: dupl
+func (u UserBlockUpdater) SetCreatedAT(createdAT *time.Time) UserBlockUpdater {
+	u.fields[string(UserBlockDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u UserBlockUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u UserBlockUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set UserBlockQuerySet
+
+// ===== BEGIN of UserBlock modifiers
+
+// UserBlockDBSchemaField describes database schema field. It requires for method 'Update'
+type UserBlockDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f UserBlockDBSchemaField) String() string {
+	return string(f)
+}
+
+// UserBlockDBSchema stores db field names of UserBlock
+var UserBlockDBSchema = struct {
+	BlockerID UserBlockDBSchemaField
+	BlockedID UserBlockDBSchemaField
+	CreatedAT UserBlockDBSchemaField
+}{
+
+	BlockerID: UserBlockDBSchemaField("blocker_id"),
+	BlockedID: UserBlockDBSchemaField("blocked_id"),
+	CreatedAT: UserBlockDBSchemaField("created_at"),
+}
+
+// Update updates UserBlock fields by primary key
+// nolint: dupl
+func (o *UserBlock) Update(db *gorm.DB, fields ...UserBlockDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"blocker_id": o.BlockerID,
+		"blocked_id": o.BlockedID,
+		"created_at": o.CreatedAT,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update UserBlock %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// UserBlockUpdater is an UserBlock updates manager
+type UserBlockUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewUserBlockUpdater creates new UserBlock updater
+// nolint: dupl
+func NewUserBlockUpdater(db *gorm.DB) UserBlockUpdater {
+	return UserBlockUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&UserBlock{}),
+	}
+}
+
+// ===== END of UserBlock modifiers
+
 // ===== BEGIN of query set UserConnectQuerySet
 
 // UserConnectQuerySet is an queryset type for UserConnect
@@ -2554,6 +2954,74 @@ func (qs UserConnectQuerySet) AppIDNotlike(appID string) UserConnectQuerySet {
 	return qs.w(qs.db.Where("app_id NOT LIKE ?", appID))
 }
 
+// AppVersionEq is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionEq(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version = ?", appVersion))
+}
+
+// AppVersionGt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionGt(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version > ?", appVersion))
+}
+
+// AppVersionGte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionGte(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version >= ?", appVersion))
+}
+
+// AppVersionIn is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionIn(appVersion ...string) UserConnectQuerySet {
+	if len(appVersion) == 0 {
+		qs.db.AddError(errors.New("must at least pass one appVersion in AppVersionIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("app_version IN (?)", appVersion))
+}
+
+// AppVersionLike is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionLike(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version LIKE ?", appVersion))
+}
+
+// AppVersionLt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionLt(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version < ?", appVersion))
+}
+
+// AppVersionLte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionLte(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version <= ?", appVersion))
+}
+
+// AppVersionNe is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionNe(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version != ?", appVersion))
+}
+
+// AppVersionNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionNotIn(appVersion ...string) UserConnectQuerySet {
+	if len(appVersion) == 0 {
+		qs.db.AddError(errors.New("must at least pass one appVersion in AppVersionNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("app_version NOT IN (?)", appVersion))
+}
+
+// AppVersionNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) AppVersionNotlike(appVersion string) UserConnectQuerySet {
+	return qs.w(qs.db.Where("app_version NOT LIKE ?", appVersion))
+}
+
 // Count is an autogenerated method
 // nolint: dupl
 func (qs UserConnectQuerySet) Count() (int, error) {
@@ -2594,6 +3062,110 @@ func (qs UserConnectQuerySet) GetUpdater() UserConnectUpdater {
 	return NewUserConnectUpdater(qs.db)
 }
 
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDEq(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDGt(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDGte(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDIn(ID ...int64) UserConnectQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDLt(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDLte(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDNe(ID int64) UserConnectQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) IDNotIn(ID ...int64) UserConnectQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// LastSeenATEq is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATEq(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at = ?", lastSeenAT))
+}
+
+// LastSeenATGt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATGt(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at > ?", lastSeenAT))
+}
+
+// LastSeenATGte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATGte(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at >= ?", lastSeenAT))
+}
+
+// LastSeenATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATIsNotNull() UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at IS NOT NULL"))
+}
+
+// LastSeenATIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATIsNull() UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at IS NULL"))
+}
+
+// LastSeenATLt is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATLt(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at < ?", lastSeenAT))
+}
+
+// LastSeenATLte is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATLte(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at <= ?", lastSeenAT))
+}
+
+// LastSeenATNe is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) LastSeenATNe(lastSeenAT time.Time) UserConnectQuerySet {
+	return qs.w(qs.db.Where("last_seen_at != ?", lastSeenAT))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs UserConnectQuerySet) Limit(limit int) UserConnectQuerySet {
@@ -2618,6 +3190,24 @@ func (qs UserConnectQuerySet) OrderAscByAppID() UserConnectQuerySet {
 	return qs.w(qs.db.Order("app_id ASC"))
 }
 
+// OrderAscByAppVersion is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderAscByAppVersion() UserConnectQuerySet {
+	return qs.w(qs.db.Order("app_version ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderAscByID() UserConnectQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByLastSeenAT is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderAscByLastSeenAT() UserConnectQuerySet {
+	return qs.w(qs.db.Order("last_seen_at ASC"))
+}
+
 // OrderAscByProviderName is an autogenerated method
 // nolint: dupl
 func (qs UserConnectQuerySet) OrderAscByProviderName() UserConnectQuerySet {
@@ -2636,6 +3226,24 @@ func (qs UserConnectQuerySet) OrderDescByAppID() UserConnectQuerySet {
 	return qs.w(qs.db.Order("app_id DESC"))
 }
 
+// OrderDescByAppVersion is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderDescByAppVersion() UserConnectQuerySet {
+	return qs.w(qs.db.Order("app_version DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderDescByID() UserConnectQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByLastSeenAT is an autogenerated method
+// nolint: dupl
+func (qs UserConnectQuerySet) OrderDescByLastSeenAT() UserConnectQuerySet {
+	return qs.w(qs.db.Order("last_seen_at DESC"))
+}
+
 // OrderDescByProviderName is an autogenerated method
 // nolint: dupl
 func (qs UserConnectQuerySet) OrderDescByProviderName() UserConnectQuerySet {
@@ -2779,6 +3387,27 @@ func (u UserConnectUpdater) SetAppID(appID string) UserConnectUpdater {
 	return u
 }
 
+// SetAppVersion is an autogenerated method
+// nolint: dupl
+func (u UserConnectUpdater) SetAppVersion(appVersion string) UserConnectUpdater {
+	u.fields[string(UserConnectDBSchema.AppVersion)] = appVersion
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u UserConnectUpdater) SetID(ID int64) UserConnectUpdater {
+	u.fields[string(UserConnectDBSchema.ID)] = ID
+	return u
+}
+
+// SetLastSeenAT is an autogenerated method
+// nolint: dupl
+func (u UserConnectUpdater) SetLastSeenAT(lastSeenAT *time.Time) UserConnectUpdater {
+	u.fields[string(UserConnectDBSchema.LastSeenAT)] = lastSeenAT
+	return u
+}
+
 // SetProviderName is an autogenerated method
 // nolint: dupl
 func (u UserConnectUpdater) SetProviderName(providerName string) UserConnectUpdater {
@@ -2821,23 +3450,32 @@ func (f UserConnectDBSchemaField) String() string {
 
 // UserConnectDBSchema stores db field names of UserConnect
 var UserConnectDBSchema = struct {
+	ID           UserConnectDBSchemaField
 	UserID       UserConnectDBSchemaField
 	ProviderName UserConnectDBSchemaField
 	AppID        UserConnectDBSchemaField
+	AppVersion   UserConnectDBSchemaField
+	LastSeenAT   UserConnectDBSchemaField
 }{
 
+	ID:           UserConnectDBSchemaField("id"),
 	UserID:       UserConnectDBSchemaField("user_id"),
 	ProviderName: UserConnectDBSchemaField("provider_name"),
 	AppID:        UserConnectDBSchemaField("app_id"),
+	AppVersion:   UserConnectDBSchemaField("app_version"),
+	LastSeenAT:   UserConnectDBSchemaField("last_seen_at"),
 }
 
 // Update updates UserConnect fields by primary key
 // nolint: dupl
 func (o *UserConnect) Update(db *gorm.DB, fields ...UserConnectDBSchemaField) error {
 	dbNameToFieldName := map[string]interface{}{
+		"id":            o.ID,
 		"user_id":       o.UserID,
 		"provider_name": o.ProviderName,
 		"app_id":        o.AppID,
+		"app_version":   o.AppVersion,
+		"last_seen_at":  o.LastSeenAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
@@ -3997,12 +4635,128 @@ func (qs UserQuerySet) LastLoginNe(lastLogin time.Time) UserQuerySet {
 	return qs.w(qs.db.Where("last_login != ?", lastLogin))
 }
 
+// LastSeenATEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATEq(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at = ?", lastSeenAT))
+}
+
+// LastSeenATGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATGt(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at > ?", lastSeenAT))
+}
+
+// LastSeenATGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATGte(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at >= ?", lastSeenAT))
+}
+
+// LastSeenATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATIsNotNull() UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at IS NOT NULL"))
+}
+
+// LastSeenATIsNull is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATIsNull() UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at IS NULL"))
+}
+
+// LastSeenATLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATLt(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at < ?", lastSeenAT))
+}
+
+// LastSeenATLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATLte(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at <= ?", lastSeenAT))
+}
+
+// LastSeenATNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LastSeenATNe(lastSeenAT time.Time) UserQuerySet {
+	return qs.w(qs.db.Where("last_seen_at != ?", lastSeenAT))
+}
+
 // Limit is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Limit(limit int) UserQuerySet {
 	return qs.w(qs.db.Limit(limit))
 }
 
+// LocaleEq is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleEq(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale = ?", locale))
+}
+
+// LocaleGt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleGt(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale > ?", locale))
+}
+
+// LocaleGte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleGte(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale >= ?", locale))
+}
+
+// LocaleIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleIn(locale ...string) UserQuerySet {
+	if len(locale) == 0 {
+		qs.db.AddError(errors.New("must at least pass one locale in LocaleIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("locale IN (?)", locale))
+}
+
+// LocaleLike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleLike(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale LIKE ?", locale))
+}
+
+// LocaleLt is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleLt(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale < ?", locale))
+}
+
+// LocaleLte is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleLte(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale <= ?", locale))
+}
+
+// LocaleNe is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleNe(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale != ?", locale))
+}
+
+// LocaleNotIn is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleNotIn(locale ...string) UserQuerySet {
+	if len(locale) == 0 {
+		qs.db.AddError(errors.New("must at least pass one locale in LocaleNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("locale NOT IN (?)", locale))
+}
+
+// LocaleNotlike is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) LocaleNotlike(locale string) UserQuerySet {
+	return qs.w(qs.db.Where("locale NOT LIKE ?", locale))
+}
+
 // Offset is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) Offset(offset int) UserQuerySet {
@@ -4057,6 +4811,18 @@ func (qs UserQuerySet) OrderAscByLastLogin() UserQuerySet {
 	return qs.w(qs.db.Order("last_login ASC"))
 }
 
+// OrderAscByLastSeenAT is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByLastSeenAT() UserQuerySet {
+	return qs.w(qs.db.Order("last_seen_at ASC"))
+}
+
+// OrderAscByLocale is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderAscByLocale() UserQuerySet {
+	return qs.w(qs.db.Order("locale ASC"))
+}
+
 // OrderAscByPhoneNum is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderAscByPhoneNum() UserQuerySet {
@@ -4117,6 +4883,18 @@ func (qs UserQuerySet) OrderDescByLastLogin() UserQuerySet {
 	return qs.w(qs.db.Order("last_login DESC"))
 }
 
+// OrderDescByLastSeenAT is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByLastSeenAT() UserQuerySet {
+	return qs.w(qs.db.Order("last_seen_at DESC"))
+}
+
+// OrderDescByLocale is an autogenerated method
+// nolint: dupl
+func (qs UserQuerySet) OrderDescByLocale() UserQuerySet {
+	return qs.w(qs.db.Order("locale DESC"))
+}
+
 // OrderDescByPhoneNum is an autogenerated method
 // nolint: dupl
 func (qs UserQuerySet) OrderDescByPhoneNum() UserQuerySet {
@@ -4344,6 +5122,20 @@ func (u UserUpdater) SetLastLogin(lastLogin *time.Time) UserUpdater {
 	return u
 }
 
+// SetLastSeenAT is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetLastSeenAT(lastSeenAT *time.Time) UserUpdater {
+	u.fields[string(UserDBSchema.LastSeenAT)] = lastSeenAT
+	return u
+}
+
+// SetLocale is an autogenerated method
+// nolint: dupl
+func (u UserUpdater) SetLocale(locale string) UserUpdater {
+	u.fields[string(UserDBSchema.Locale)] = locale
+	return u
+}
+
 // SetPhoneNum is an autogenerated method
 // nolint: dupl
 func (u UserUpdater) SetPhoneNum(phoneNum string) UserUpdater {
@@ -4403,6 +5195,8 @@ var UserDBSchema = struct {
 	Active       UserDBSchemaField
 	LastLogin    UserDBSchemaField
 	RegisteredAt UserDBSchemaField
+	Locale       UserDBSchemaField
+	LastSeenAT   UserDBSchemaField
 }{
 
 	ID:           UserDBSchemaField("id"),
@@ -4415,6 +5209,8 @@ var UserDBSchema = struct {
 	Active:       UserDBSchemaField("active"),
 	LastLogin:    UserDBSchemaField("last_login"),
 	RegisteredAt: UserDBSchemaField("registered_at"),
+	Locale:       UserDBSchemaField("locale"),
+	LastSeenAT:   UserDBSchemaField("last_seen_at"),
 }
 
 // Update updates User fields by primary key
@@ -4431,6 +5227,8 @@ func (o *User) Update(db *gorm.DB, fields ...UserDBSchemaField) error {
 		"active":        o.Active,
 		"last_login":    o.LastLogin,
 		"registered_at": o.RegisteredAt,
+		"locale":        o.Locale,
+		"last_seen_at":  o.LastSeenAT,
 	}
 	u := map[string]interface{}{}
 	for _, f := range fields {
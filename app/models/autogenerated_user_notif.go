@@ -12,6 +12,1738 @@ import (
 
 // ===== BEGIN of all query sets
 
+// ===== BEGIN of query set NotifBufferQuerySet
+
+// NotifBufferQuerySet is an queryset type for NotifBuffer
+type NotifBufferQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNotifBufferQuerySet constructs new NotifBufferQuerySet
+func NewNotifBufferQuerySet(db *gorm.DB) NotifBufferQuerySet {
+	return NotifBufferQuerySet{
+		db: db.Model(&NotifBuffer{}),
+	}
+}
+
+func (qs NotifBufferQuerySet) w(db *gorm.DB) NotifBufferQuerySet {
+	return NewNotifBufferQuerySet(db)
+}
+
+func (qs NotifBufferQuerySet) Select(fields ...NotifBufferDBSchemaField) NotifBufferQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *NotifBuffer) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *NotifBuffer) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) All(ret *[]NotifBuffer) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// CreatedATEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATEq(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at = ?", createdAT))
+}
+
+// CreatedATGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATGt(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at > ?", createdAT))
+}
+
+// CreatedATGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATGte(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at >= ?", createdAT))
+}
+
+// CreatedATIsNotNull is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATIsNotNull() NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at IS NOT NULL"))
+}
+
+// CreatedATIsNull is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATIsNull() NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at IS NULL"))
+}
+
+// CreatedATLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATLt(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at < ?", createdAT))
+}
+
+// CreatedATLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATLte(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at <= ?", createdAT))
+}
+
+// CreatedATNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) CreatedATNe(createdAT time.Time) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("created_at != ?", createdAT))
+}
+
+// DataEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataEq(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data = ?", data))
+}
+
+// DataGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataGt(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data > ?", data))
+}
+
+// DataGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataGte(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data >= ?", data))
+}
+
+// DataIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataIn(data ...string) NotifBufferQuerySet {
+	if len(data) == 0 {
+		qs.db.AddError(errors.New("must at least pass one data in DataIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("data IN (?)", data))
+}
+
+// DataLike is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataLike(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data LIKE ?", data))
+}
+
+// DataLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataLt(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data < ?", data))
+}
+
+// DataLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataLte(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data <= ?", data))
+}
+
+// DataNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataNe(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data != ?", data))
+}
+
+// DataNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataNotIn(data ...string) NotifBufferQuerySet {
+	if len(data) == 0 {
+		qs.db.AddError(errors.New("must at least pass one data in DataNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("data NOT IN (?)", data))
+}
+
+// DataNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DataNotlike(data string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("data NOT LIKE ?", data))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) Delete() error {
+	return qs.db.Delete(NotifBuffer{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(NotifBuffer{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(NotifBuffer{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) GetUpdater() NotifBufferUpdater {
+	return NewNotifBufferUpdater(qs.db)
+}
+
+// IDEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDEq(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id = ?", ID))
+}
+
+// IDGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDGt(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id > ?", ID))
+}
+
+// IDGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDGte(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id >= ?", ID))
+}
+
+// IDIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDIn(ID ...int64) NotifBufferQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id IN (?)", ID))
+}
+
+// IDLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDLt(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id < ?", ID))
+}
+
+// IDLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDLte(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id <= ?", ID))
+}
+
+// IDNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDNe(ID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("id != ?", ID))
+}
+
+// IDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) IDNotIn(ID ...int64) NotifBufferQuerySet {
+	if len(ID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one ID in IDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("id NOT IN (?)", ID))
+}
+
+// ItemEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemEq(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item = ?", item))
+}
+
+// ItemGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemGt(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item > ?", item))
+}
+
+// ItemGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemGte(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item >= ?", item))
+}
+
+// ItemIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemIn(item ...string) NotifBufferQuerySet {
+	if len(item) == 0 {
+		qs.db.AddError(errors.New("must at least pass one item in ItemIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("item IN (?)", item))
+}
+
+// ItemLike is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemLike(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item LIKE ?", item))
+}
+
+// ItemLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemLt(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item < ?", item))
+}
+
+// ItemLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemLte(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item <= ?", item))
+}
+
+// ItemNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemNe(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item != ?", item))
+}
+
+// ItemNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemNotIn(item ...string) NotifBufferQuerySet {
+	if len(item) == 0 {
+		qs.db.AddError(errors.New("must at least pass one item in ItemNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("item NOT IN (?)", item))
+}
+
+// ItemNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) ItemNotlike(item string) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("item NOT LIKE ?", item))
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) Limit(limit int) NotifBufferQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NotifTypeEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeEq(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type = ?", notifType))
+}
+
+// NotifTypeGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeGt(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type > ?", notifType))
+}
+
+// NotifTypeGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeGte(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type >= ?", notifType))
+}
+
+// NotifTypeIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeIn(notifType ...int) NotifBufferQuerySet {
+	if len(notifType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one notifType in NotifTypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("notif_type IN (?)", notifType))
+}
+
+// NotifTypeLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeLt(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type < ?", notifType))
+}
+
+// NotifTypeLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeLte(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type <= ?", notifType))
+}
+
+// NotifTypeNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeNe(notifType int) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("notif_type != ?", notifType))
+}
+
+// NotifTypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) NotifTypeNotIn(notifType ...int) NotifBufferQuerySet {
+	if len(notifType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one notifType in NotifTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("notif_type NOT IN (?)", notifType))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) Offset(offset int) NotifBufferQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NotifBufferQuerySet) One(ret *NotifBuffer) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByCreatedAT() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("created_at ASC"))
+}
+
+// OrderAscByData is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByData() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("data ASC"))
+}
+
+// OrderAscByID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("id ASC"))
+}
+
+// OrderAscByItem is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByItem() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("item ASC"))
+}
+
+// OrderAscByNotifType is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByNotifType() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("notif_type ASC"))
+}
+
+// OrderAscByTargetID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByTargetID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("target_id ASC"))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderAscByUserID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
+}
+
+// OrderDescByCreatedAT is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByCreatedAT() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("created_at DESC"))
+}
+
+// OrderDescByData is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByData() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("data DESC"))
+}
+
+// OrderDescByID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("id DESC"))
+}
+
+// OrderDescByItem is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByItem() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("item DESC"))
+}
+
+// OrderDescByNotifType is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByNotifType() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("notif_type DESC"))
+}
+
+// OrderDescByTargetID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByTargetID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("target_id DESC"))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) OrderDescByUserID() NotifBufferQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
+}
+
+// TargetIDEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDEq(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id = ?", targetID))
+}
+
+// TargetIDGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDGt(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id > ?", targetID))
+}
+
+// TargetIDGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDGte(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id >= ?", targetID))
+}
+
+// TargetIDIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDIn(targetID ...int64) NotifBufferQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("target_id IN (?)", targetID))
+}
+
+// TargetIDLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDLt(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id < ?", targetID))
+}
+
+// TargetIDLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDLte(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id <= ?", targetID))
+}
+
+// TargetIDNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDNe(targetID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("target_id != ?", targetID))
+}
+
+// TargetIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) TargetIDNotIn(targetID ...int64) NotifBufferQuerySet {
+	if len(targetID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one targetID in TargetIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("target_id NOT IN (?)", targetID))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDEq(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDGt(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDGte(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDIn(userID ...int64) NotifBufferQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDLt(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDLte(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDNe(userID int64) NotifBufferQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifBufferQuerySet) UserIDNotIn(userID ...int64) NotifBufferQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+}
+
+// SetCreatedAT is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetCreatedAT(createdAT *time.Time) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.CreatedAT)] = createdAT
+	return u
+}
+
+// SetData is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetData(data string) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.Data)] = data
+	return u
+}
+
+// SetID is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetID(ID int64) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.ID)] = ID
+	return u
+}
+
+// SetItem is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetItem(item string) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.Item)] = item
+	return u
+}
+
+// SetNotifType is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetNotifType(notifType int) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.NotifType)] = notifType
+	return u
+}
+
+// SetTargetID is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetTargetID(targetID int64) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.TargetID)] = targetID
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) SetUserID(userID int64) NotifBufferUpdater {
+	u.fields[string(NotifBufferDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NotifBufferUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set NotifBufferQuerySet
+
+// ===== BEGIN of NotifBuffer modifiers
+
+// NotifBufferDBSchemaField describes database schema field. It requires for method 'Update'
+type NotifBufferDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NotifBufferDBSchemaField) String() string {
+	return string(f)
+}
+
+// NotifBufferDBSchema stores db field names of NotifBuffer
+var NotifBufferDBSchema = struct {
+	ID        NotifBufferDBSchemaField
+	UserID    NotifBufferDBSchemaField
+	NotifType NotifBufferDBSchemaField
+	TargetID  NotifBufferDBSchemaField
+	Data      NotifBufferDBSchemaField
+	Item      NotifBufferDBSchemaField
+	CreatedAT NotifBufferDBSchemaField
+}{
+
+	ID:        NotifBufferDBSchemaField("id"),
+	UserID:    NotifBufferDBSchemaField("user_id"),
+	NotifType: NotifBufferDBSchemaField("notif_type"),
+	TargetID:  NotifBufferDBSchemaField("target_id"),
+	Data:      NotifBufferDBSchemaField("data"),
+	Item:      NotifBufferDBSchemaField("item"),
+	CreatedAT: NotifBufferDBSchemaField("created_at"),
+}
+
+// Update updates NotifBuffer fields by primary key
+// nolint: dupl
+func (o *NotifBuffer) Update(db *gorm.DB, fields ...NotifBufferDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"id":         o.ID,
+		"user_id":    o.UserID,
+		"notif_type": o.NotifType,
+		"target_id":  o.TargetID,
+		"data":       o.Data,
+		"item":       o.Item,
+		"created_at": o.CreatedAT,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update NotifBuffer %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NotifBufferUpdater is an NotifBuffer updates manager
+type NotifBufferUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNotifBufferUpdater creates new NotifBuffer updater
+// nolint: dupl
+func NewNotifBufferUpdater(db *gorm.DB) NotifBufferUpdater {
+	return NotifBufferUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&NotifBuffer{}),
+	}
+}
+
+// ===== END of NotifBuffer modifiers
+
+// ===== BEGIN of query set NotifPreferenceQuerySet
+
+// NotifPreferenceQuerySet is an queryset type for NotifPreference
+type NotifPreferenceQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNotifPreferenceQuerySet constructs new NotifPreferenceQuerySet
+func NewNotifPreferenceQuerySet(db *gorm.DB) NotifPreferenceQuerySet {
+	return NotifPreferenceQuerySet{
+		db: db.Model(&NotifPreference{}),
+	}
+}
+
+func (qs NotifPreferenceQuerySet) w(db *gorm.DB) NotifPreferenceQuerySet {
+	return NewNotifPreferenceQuerySet(db)
+}
+
+func (qs NotifPreferenceQuerySet) Select(fields ...NotifPreferenceDBSchemaField) NotifPreferenceQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *NotifPreference) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *NotifPreference) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) All(ret *[]NotifPreference) error {
+	return qs.db.Find(ret).Error
+}
+
+// ChannelsEq is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsEq(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels = ?", channels))
+}
+
+// ChannelsGt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsGt(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels > ?", channels))
+}
+
+// ChannelsGte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsGte(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels >= ?", channels))
+}
+
+// ChannelsIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsIn(channels ...string) NotifPreferenceQuerySet {
+	if len(channels) == 0 {
+		qs.db.AddError(errors.New("must at least pass one channels in ChannelsIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("channels IN (?)", channels))
+}
+
+// ChannelsLike is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsLike(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels LIKE ?", channels))
+}
+
+// ChannelsLt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsLt(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels < ?", channels))
+}
+
+// ChannelsLte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsLte(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels <= ?", channels))
+}
+
+// ChannelsNe is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsNe(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels != ?", channels))
+}
+
+// ChannelsNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsNotIn(channels ...string) NotifPreferenceQuerySet {
+	if len(channels) == 0 {
+		qs.db.AddError(errors.New("must at least pass one channels in ChannelsNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("channels NOT IN (?)", channels))
+}
+
+// ChannelsNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) ChannelsNotlike(channels string) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("channels NOT LIKE ?", channels))
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) Delete() error {
+	return qs.db.Delete(NotifPreference{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(NotifPreference{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(NotifPreference{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) GetUpdater() NotifPreferenceUpdater {
+	return NewNotifPreferenceUpdater(qs.db)
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) Limit(limit int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// NotifTypeEq is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeEq(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type = ?", notifType))
+}
+
+// NotifTypeGt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeGt(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type > ?", notifType))
+}
+
+// NotifTypeGte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeGte(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type >= ?", notifType))
+}
+
+// NotifTypeIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeIn(notifType ...int) NotifPreferenceQuerySet {
+	if len(notifType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one notifType in NotifTypeIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("notif_type IN (?)", notifType))
+}
+
+// NotifTypeLt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeLt(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type < ?", notifType))
+}
+
+// NotifTypeLte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeLte(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type <= ?", notifType))
+}
+
+// NotifTypeNe is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeNe(notifType int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("notif_type != ?", notifType))
+}
+
+// NotifTypeNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) NotifTypeNotIn(notifType ...int) NotifPreferenceQuerySet {
+	if len(notifType) == 0 {
+		qs.db.AddError(errors.New("must at least pass one notifType in NotifTypeNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("notif_type NOT IN (?)", notifType))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) Offset(offset int) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NotifPreferenceQuerySet) One(ret *NotifPreference) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByChannels is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderAscByChannels() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("channels ASC"))
+}
+
+// OrderAscByNotifType is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderAscByNotifType() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("notif_type ASC"))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderAscByUserID() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
+}
+
+// OrderDescByChannels is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderDescByChannels() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("channels DESC"))
+}
+
+// OrderDescByNotifType is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderDescByNotifType() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("notif_type DESC"))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) OrderDescByUserID() NotifPreferenceQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDEq(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDGt(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDGte(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDIn(userID ...int64) NotifPreferenceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDLt(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDLte(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDNe(userID int64) NotifPreferenceQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifPreferenceQuerySet) UserIDNotIn(userID ...int64) NotifPreferenceQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+}
+
+// SetChannels is an autogenerated method
+// nolint: dupl
+func (u NotifPreferenceUpdater) SetChannels(channels string) NotifPreferenceUpdater {
+	u.fields[string(NotifPreferenceDBSchema.Channels)] = channels
+	return u
+}
+
+// SetNotifType is an autogenerated method
+// nolint: dupl
+func (u NotifPreferenceUpdater) SetNotifType(notifType int) NotifPreferenceUpdater {
+	u.fields[string(NotifPreferenceDBSchema.NotifType)] = notifType
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u NotifPreferenceUpdater) SetUserID(userID int64) NotifPreferenceUpdater {
+	u.fields[string(NotifPreferenceDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NotifPreferenceUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NotifPreferenceUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set NotifPreferenceQuerySet
+
+// ===== BEGIN of NotifPreference modifiers
+
+// NotifPreferenceDBSchemaField describes database schema field. It requires for method 'Update'
+type NotifPreferenceDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NotifPreferenceDBSchemaField) String() string {
+	return string(f)
+}
+
+// NotifPreferenceDBSchema stores db field names of NotifPreference
+var NotifPreferenceDBSchema = struct {
+	UserID    NotifPreferenceDBSchemaField
+	NotifType NotifPreferenceDBSchemaField
+	Channels  NotifPreferenceDBSchemaField
+}{
+
+	UserID:    NotifPreferenceDBSchemaField("user_id"),
+	NotifType: NotifPreferenceDBSchemaField("notif_type"),
+	Channels:  NotifPreferenceDBSchemaField("channels"),
+}
+
+// Update updates NotifPreference fields by primary key
+// nolint: dupl
+func (o *NotifPreference) Update(db *gorm.DB, fields ...NotifPreferenceDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"user_id":    o.UserID,
+		"notif_type": o.NotifType,
+		"channels":   o.Channels,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update NotifPreference %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NotifPreferenceUpdater is an NotifPreference updates manager
+type NotifPreferenceUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNotifPreferenceUpdater creates new NotifPreference updater
+// nolint: dupl
+func NewNotifPreferenceUpdater(db *gorm.DB) NotifPreferenceUpdater {
+	return NotifPreferenceUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&NotifPreference{}),
+	}
+}
+
+// ===== END of NotifPreference modifiers
+
+// ===== BEGIN of query set NotifSettingQuerySet
+
+// NotifSettingQuerySet is an queryset type for NotifSetting
+type NotifSettingQuerySet struct {
+	db *gorm.DB
+}
+
+// NewNotifSettingQuerySet constructs new NotifSettingQuerySet
+func NewNotifSettingQuerySet(db *gorm.DB) NotifSettingQuerySet {
+	return NotifSettingQuerySet{
+		db: db.Model(&NotifSetting{}),
+	}
+}
+
+func (qs NotifSettingQuerySet) w(db *gorm.DB) NotifSettingQuerySet {
+	return NewNotifSettingQuerySet(db)
+}
+
+func (qs NotifSettingQuerySet) Select(fields ...NotifSettingDBSchemaField) NotifSettingQuerySet {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, f.String())
+	}
+
+	return qs.w(qs.db.Select(strings.Join(names, ",")))
+}
+
+// Create is an autogenerated method
+// nolint: dupl
+func (o *NotifSetting) Create(db *gorm.DB) error {
+	return db.Create(o).Error
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (o *NotifSetting) Delete(db *gorm.DB) error {
+	return db.Delete(o).Error
+}
+
+// All is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) All(ret *[]NotifSetting) error {
+	return qs.db.Find(ret).Error
+}
+
+// Count is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) Count() (int, error) {
+	var count int
+	err := qs.db.Count(&count).Error
+	return count, err
+}
+
+// DailyDigestEq is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DailyDigestEq(dailyDigest bool) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("daily_digest = ?", dailyDigest))
+}
+
+// DailyDigestIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DailyDigestIn(dailyDigest ...bool) NotifSettingQuerySet {
+	if len(dailyDigest) == 0 {
+		qs.db.AddError(errors.New("must at least pass one dailyDigest in DailyDigestIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("daily_digest IN (?)", dailyDigest))
+}
+
+// DailyDigestNe is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DailyDigestNe(dailyDigest bool) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("daily_digest != ?", dailyDigest))
+}
+
+// DailyDigestNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DailyDigestNotIn(dailyDigest ...bool) NotifSettingQuerySet {
+	if len(dailyDigest) == 0 {
+		qs.db.AddError(errors.New("must at least pass one dailyDigest in DailyDigestNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("daily_digest NOT IN (?)", dailyDigest))
+}
+
+// Delete is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) Delete() error {
+	return qs.db.Delete(NotifSetting{}).Error
+}
+
+// DeleteNum is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DeleteNum() (int64, error) {
+	db := qs.db.Delete(NotifSetting{})
+	return db.RowsAffected, db.Error
+}
+
+// DeleteNumUnscoped is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) DeleteNumUnscoped() (int64, error) {
+	db := qs.db.Unscoped().Delete(NotifSetting{})
+	return db.RowsAffected, db.Error
+}
+
+// GetDB is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) GetDB() *gorm.DB {
+	return qs.db
+}
+
+// GetUpdater is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) GetUpdater() NotifSettingUpdater {
+	return NewNotifSettingUpdater(qs.db)
+}
+
+// Limit is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) Limit(limit int) NotifSettingQuerySet {
+	return qs.w(qs.db.Limit(limit))
+}
+
+// Offset is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) Offset(offset int) NotifSettingQuerySet {
+	return qs.w(qs.db.Offset(offset))
+}
+
+// One is used to retrieve one result. It returns gorm.ErrRecordNotFound
+// if nothing was fetched
+func (qs NotifSettingQuerySet) One(ret *NotifSetting) error {
+	return qs.db.First(ret).Error
+}
+
+// OrderAscByDailyDigest is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderAscByDailyDigest() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("daily_digest ASC"))
+}
+
+// OrderAscByQuietEnd is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderAscByQuietEnd() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("quiet_end ASC"))
+}
+
+// OrderAscByQuietStart is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderAscByQuietStart() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("quiet_start ASC"))
+}
+
+// OrderAscByTimezone is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderAscByTimezone() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("timezone ASC"))
+}
+
+// OrderAscByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderAscByUserID() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("user_id ASC"))
+}
+
+// OrderDescByDailyDigest is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderDescByDailyDigest() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("daily_digest DESC"))
+}
+
+// OrderDescByQuietEnd is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderDescByQuietEnd() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("quiet_end DESC"))
+}
+
+// OrderDescByQuietStart is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderDescByQuietStart() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("quiet_start DESC"))
+}
+
+// OrderDescByTimezone is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderDescByTimezone() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("timezone DESC"))
+}
+
+// OrderDescByUserID is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) OrderDescByUserID() NotifSettingQuerySet {
+	return qs.w(qs.db.Order("user_id DESC"))
+}
+
+// QuietEndEq is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndEq(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end = ?", quietEnd))
+}
+
+// QuietEndGt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndGt(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end > ?", quietEnd))
+}
+
+// QuietEndGte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndGte(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end >= ?", quietEnd))
+}
+
+// QuietEndIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndIn(quietEnd ...string) NotifSettingQuerySet {
+	if len(quietEnd) == 0 {
+		qs.db.AddError(errors.New("must at least pass one quietEnd in QuietEndIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("quiet_end IN (?)", quietEnd))
+}
+
+// QuietEndLike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndLike(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end LIKE ?", quietEnd))
+}
+
+// QuietEndLt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndLt(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end < ?", quietEnd))
+}
+
+// QuietEndLte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndLte(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end <= ?", quietEnd))
+}
+
+// QuietEndNe is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndNe(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end != ?", quietEnd))
+}
+
+// QuietEndNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndNotIn(quietEnd ...string) NotifSettingQuerySet {
+	if len(quietEnd) == 0 {
+		qs.db.AddError(errors.New("must at least pass one quietEnd in QuietEndNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("quiet_end NOT IN (?)", quietEnd))
+}
+
+// QuietEndNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietEndNotlike(quietEnd string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_end NOT LIKE ?", quietEnd))
+}
+
+// QuietStartEq is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartEq(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start = ?", quietStart))
+}
+
+// QuietStartGt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartGt(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start > ?", quietStart))
+}
+
+// QuietStartGte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartGte(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start >= ?", quietStart))
+}
+
+// QuietStartIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartIn(quietStart ...string) NotifSettingQuerySet {
+	if len(quietStart) == 0 {
+		qs.db.AddError(errors.New("must at least pass one quietStart in QuietStartIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("quiet_start IN (?)", quietStart))
+}
+
+// QuietStartLike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartLike(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start LIKE ?", quietStart))
+}
+
+// QuietStartLt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartLt(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start < ?", quietStart))
+}
+
+// QuietStartLte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartLte(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start <= ?", quietStart))
+}
+
+// QuietStartNe is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartNe(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start != ?", quietStart))
+}
+
+// QuietStartNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartNotIn(quietStart ...string) NotifSettingQuerySet {
+	if len(quietStart) == 0 {
+		qs.db.AddError(errors.New("must at least pass one quietStart in QuietStartNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("quiet_start NOT IN (?)", quietStart))
+}
+
+// QuietStartNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) QuietStartNotlike(quietStart string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("quiet_start NOT LIKE ?", quietStart))
+}
+
+// TimezoneEq is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneEq(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone = ?", timezone))
+}
+
+// TimezoneGt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneGt(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone > ?", timezone))
+}
+
+// TimezoneGte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneGte(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone >= ?", timezone))
+}
+
+// TimezoneIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneIn(timezone ...string) NotifSettingQuerySet {
+	if len(timezone) == 0 {
+		qs.db.AddError(errors.New("must at least pass one timezone in TimezoneIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("timezone IN (?)", timezone))
+}
+
+// TimezoneLike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneLike(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone LIKE ?", timezone))
+}
+
+// TimezoneLt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneLt(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone < ?", timezone))
+}
+
+// TimezoneLte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneLte(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone <= ?", timezone))
+}
+
+// TimezoneNe is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneNe(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone != ?", timezone))
+}
+
+// TimezoneNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneNotIn(timezone ...string) NotifSettingQuerySet {
+	if len(timezone) == 0 {
+		qs.db.AddError(errors.New("must at least pass one timezone in TimezoneNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("timezone NOT IN (?)", timezone))
+}
+
+// TimezoneNotlike is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) TimezoneNotlike(timezone string) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("timezone NOT LIKE ?", timezone))
+}
+
+// UserIDEq is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDEq(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id = ?", userID))
+}
+
+// UserIDGt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDGt(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id > ?", userID))
+}
+
+// UserIDGte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDGte(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id >= ?", userID))
+}
+
+// UserIDIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDIn(userID ...int64) NotifSettingQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id IN (?)", userID))
+}
+
+// UserIDLt is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDLt(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id < ?", userID))
+}
+
+// UserIDLte is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDLte(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id <= ?", userID))
+}
+
+// UserIDNe is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDNe(userID int64) NotifSettingQuerySet {
+	return qs.w(qs.db.Where("user_id != ?", userID))
+}
+
+// UserIDNotIn is an autogenerated method
+// nolint: dupl
+func (qs NotifSettingQuerySet) UserIDNotIn(userID ...int64) NotifSettingQuerySet {
+	if len(userID) == 0 {
+		qs.db.AddError(errors.New("must at least pass one userID in UserIDNotIn"))
+		return qs.w(qs.db)
+	}
+	return qs.w(qs.db.Where("user_id NOT IN (?)", userID))
+}
+
+// SetDailyDigest is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) SetDailyDigest(dailyDigest bool) NotifSettingUpdater {
+	u.fields[string(NotifSettingDBSchema.DailyDigest)] = dailyDigest
+	return u
+}
+
+// SetQuietEnd is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) SetQuietEnd(quietEnd string) NotifSettingUpdater {
+	u.fields[string(NotifSettingDBSchema.QuietEnd)] = quietEnd
+	return u
+}
+
+// SetQuietStart is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) SetQuietStart(quietStart string) NotifSettingUpdater {
+	u.fields[string(NotifSettingDBSchema.QuietStart)] = quietStart
+	return u
+}
+
+// SetTimezone is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) SetTimezone(timezone string) NotifSettingUpdater {
+	u.fields[string(NotifSettingDBSchema.Timezone)] = timezone
+	return u
+}
+
+// SetUserID is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) SetUserID(userID int64) NotifSettingUpdater {
+	u.fields[string(NotifSettingDBSchema.UserID)] = userID
+	return u
+}
+
+// Update is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) Update() error {
+	return u.db.Updates(u.fields).Error
+}
+
+// UpdateNum is an autogenerated method
+// nolint: dupl
+func (u NotifSettingUpdater) UpdateNum() (int64, error) {
+	db := u.db.Updates(u.fields)
+	return db.RowsAffected, db.Error
+}
+
+// ===== END of query set NotifSettingQuerySet
+
+// ===== BEGIN of NotifSetting modifiers
+
+// NotifSettingDBSchemaField describes database schema field. It requires for method 'Update'
+type NotifSettingDBSchemaField string
+
+// String method returns string representation of field.
+// nolint: dupl
+func (f NotifSettingDBSchemaField) String() string {
+	return string(f)
+}
+
+// NotifSettingDBSchema stores db field names of NotifSetting
+var NotifSettingDBSchema = struct {
+	UserID      NotifSettingDBSchemaField
+	QuietStart  NotifSettingDBSchemaField
+	QuietEnd    NotifSettingDBSchemaField
+	Timezone    NotifSettingDBSchemaField
+	DailyDigest NotifSettingDBSchemaField
+}{
+
+	UserID:      NotifSettingDBSchemaField("user_id"),
+	QuietStart:  NotifSettingDBSchemaField("quiet_start"),
+	QuietEnd:    NotifSettingDBSchemaField("quiet_end"),
+	Timezone:    NotifSettingDBSchemaField("timezone"),
+	DailyDigest: NotifSettingDBSchemaField("daily_digest"),
+}
+
+// Update updates NotifSetting fields by primary key
+// nolint: dupl
+func (o *NotifSetting) Update(db *gorm.DB, fields ...NotifSettingDBSchemaField) error {
+	dbNameToFieldName := map[string]interface{}{
+		"user_id":      o.UserID,
+		"quiet_start":  o.QuietStart,
+		"quiet_end":    o.QuietEnd,
+		"timezone":     o.Timezone,
+		"daily_digest": o.DailyDigest,
+	}
+	u := map[string]interface{}{}
+	for _, f := range fields {
+		fs := f.String()
+		u[fs] = dbNameToFieldName[fs]
+	}
+	if err := db.Model(o).Updates(u).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return err
+		}
+
+		return fmt.Errorf("can't update NotifSetting %v fields %v: %s",
+			o, fields, err)
+	}
+
+	return nil
+}
+
+// NotifSettingUpdater is an NotifSetting updates manager
+type NotifSettingUpdater struct {
+	fields map[string]interface{}
+	db     *gorm.DB
+}
+
+// NewNotifSettingUpdater creates new NotifSetting updater
+// nolint: dupl
+func NewNotifSettingUpdater(db *gorm.DB) NotifSettingUpdater {
+	return NotifSettingUpdater{
+		fields: map[string]interface{}{},
+		db:     db.Model(&NotifSetting{}),
+	}
+}
+
+// ===== END of NotifSetting modifiers
+
 // ===== BEGIN of query set UserNotifQuerySet
 
 // UserNotifQuerySet is an queryset type for UserNotif
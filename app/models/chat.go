@@ -15,6 +15,7 @@ type Chat struct {
 	ID           int64      `json:"id"`
 	InitiatorID  int64      `json:"initiator_id"`
 	SubscriberID int64      `json:"subscriber_id"`
+	ProductID    *int64     `json:"product_id"`
 	LastUpdated  *time.Time `json:"last_updated"`
 	TS           *time.Time `json:"ts"`
 }
@@ -30,7 +31,23 @@ type Message struct {
 	Deleted        bool       `json:"deleted"`
 	AttachmentKind int        `json:"attachment_kind"`
 	AttachmentData string     `json:"attachment_data"`
+	AttachmentID   *int64     `json:"attachment_id"`
 	TS             *time.Time `json:"ts"`
+	EditedAT       *time.Time `json:"edited_at"`
+}
+
+// ChatAttachment model file yang diupload ke chat room, isi file ada di storage
+// gen:qs
+type ChatAttachment struct {
+	ID          int64      `json:"id"`
+	ChatID      int64      `json:"chat_id"`
+	UploaderID  int64      `json:"uploader_id"`
+	StorageKey  string     `json:"-"`
+	FileName    string     `json:"file_name"`
+	ContentType string     `json:"content_type"`
+	Kind        int        `json:"kind"`
+	Size        int64      `json:"size"`
+	CreatedAT   *time.Time `json:"created_at"`
 }
 
 // ChatHistory model
@@ -42,6 +59,23 @@ type ChatHistory struct {
 	MessageID int64 `json:"message_id"`
 }
 
+// ChatRead model read pointer participant pada chat room
+// gen:qs
+type ChatRead struct {
+	ChatID     int64      `json:"chat_id" gorm:"primary_key"`
+	UserID     int64      `json:"user_id" gorm:"primary_key"`
+	LastReadID int64      `json:"last_read_id"`
+	ReadAT     *time.Time `json:"read_at"`
+}
+
+// ChatProduct model product yang pernah dibicarakan pada chat room
+// gen:qs
+type ChatProduct struct {
+	ChatID    int64      `json:"chat_id" gorm:"primary_key"`
+	ProductID int64      `json:"product_id" gorm:"primary_key"`
+	TS        *time.Time `json:"ts"`
+}
+
 // TableName override for model ChatHistory
 func (ChatHistory) TableName() string {
 	return "user_chat_histories"
@@ -57,12 +91,22 @@ func (c *Chat) ToAPI(userID int64) types.Chat {
 		dao.Where("id = ?", c.InitiatorID).First(&display)
 	}
 
+	var product interface{}
+	if c.ProductID != nil {
+		p := Product{}
+		if err := app.DB.Where("id = ?", *c.ProductID).First(&p).Error; err == nil {
+			product = p.ToAPI(&userID)
+		}
+	}
+
 	return types.Chat{
 		ID:           c.ID,
 		InitiatorID:  c.InitiatorID,
 		SubscriberID: c.SubscriberID,
+		ProductID:    c.ProductID,
 		LastUpdated:  c.LastUpdated,
 		TS:           c.TS,
 		Display:      display,
+		Product:      product,
 	}
 }
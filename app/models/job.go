@@ -0,0 +1,45 @@
+package models
+
+import "time"
+
+//go:generate goqueryset -in job.go
+
+// Job model untuk antrian job yang persisten
+// gen:qs
+type Job struct {
+	ID          int64      `json:"id"`
+	JobType     string     `json:"job_type"`
+	Payload     string     `json:"payload"`
+	Attempts    int        `json:"attempts"`
+	MaxAttempts int        `json:"max_attempts"`
+	LastError   string     `json:"last_error"`
+	RunAT       *time.Time `json:"run_at"`
+	LockedAT    *time.Time `json:"locked_at"`
+	UniqueKey   *string    `json:"unique_key"`
+	GroupKey    *string    `json:"group_key"`
+	CreatedAT   *time.Time `json:"created_at"`
+}
+
+// DeadJob model untuk job yang tetap gagal setelah max attempts
+// gen:qs
+type DeadJob struct {
+	ID        int64      `json:"id"`
+	JobType   string     `json:"job_type"`
+	Payload   string     `json:"payload"`
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"last_error"`
+	CreatedAT *time.Time `json:"created_at"`
+	FailedAT  *time.Time `json:"failed_at"`
+}
+
+// JobSchedule model untuk job yang berjalan berulang sesuai cron spec
+// gen:qs
+type JobSchedule struct {
+	Name      string     `json:"name" gorm:"primary_key"`
+	Spec      string     `json:"spec"`
+	JobType   string     `json:"job_type"`
+	Payload   string     `json:"payload"`
+	NextRunAT *time.Time `json:"next_run_at"`
+	LastRunAT *time.Time `json:"last_run_at"`
+	CreatedAT *time.Time `json:"created_at"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+//go:generate goqueryset -in outbox.go
+
+// OutboxEvent model event yang disimpan dalam transaksi yang sama dengan perubahan datanya
+// gen:qs
+type OutboxEvent struct {
+	ID          int64      `json:"id"`
+	EventType   string     `json:"event_type"`
+	Payload     string     `json:"payload"`
+	AggregateID *string    `json:"aggregate_id"`
+	CreatedAT   *time.Time `json:"created_at"`
+	PublishedAT *time.Time `json:"published_at"`
+}
+
+// ProcessedEvent model event yang sudah diproses oleh subscriber
+// gen:qs
+type ProcessedEvent struct {
+	EventID     int64      `json:"event_id" gorm:"primary_key"`
+	Subscriber  string     `json:"subscriber" gorm:"primary_key"`
+	ProcessedAT *time.Time `json:"processed_at"`
+}
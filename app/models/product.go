@@ -40,12 +40,40 @@ type ProductBidder struct {
 	User      *UserSimple `json:"user,omitempty"`
 }
 
-// ProductImage model
+// ProductImage model gambar product beserta url variant-nya, diurutkan berdasarkan position
+// dan gambar pertama adalah primary. ProductID kosong untuk gambar yang sudah diupload
+// namun belum dipasang ke product
 // gen:qs
 type ProductImage struct {
-	ID        int64  `json:"id"`
-	ProductID int64  `json:"-"`
-	ImageURL  string `json:"image_url"`
+	ID         int64      `json:"id"`
+	ProductID  *int64     `json:"-"`
+	UploaderID *int64     `json:"-"`
+	StorageKey string     `json:"-"`
+	ImageURL   string     `json:"image_url"`
+	ThumbURL   string     `json:"thumb_url"`
+	MediumURL  string     `json:"medium_url"`
+	Width      int        `json:"width"`
+	Height     int        `json:"height"`
+	Position   int        `json:"position"`
+	IsPrimary  bool       `json:"primary"`
+	CreatedAT  *time.Time `json:"created_at"`
+	// Phash perceptual hash gambar, kosong untuk gambar lama berupa url eksternal
+	Phash *int64 `json:"-"`
+}
+
+// ImageDuplicateFlag gambar product yang mirip dengan gambar product milik store lain,
+// menunggu keputusan admin selama ResolvedAT masih kosong
+// gen:qs
+type ImageDuplicateFlag struct {
+	ID               int64      `json:"id"`
+	ProductID        int64      `json:"product_id"`
+	ImageID          int64      `json:"image_id"`
+	MatchedProductID int64      `json:"matched_product_id"`
+	MatchedImageID   int64      `json:"matched_image_id"`
+	Distance         int        `json:"distance"`
+	ResolvedBy       *int64     `json:"resolved_by"`
+	ResolvedAT       *time.Time `json:"resolved_at"`
+	CreatedAT        *time.Time `json:"created_at"`
 }
 
 // ProductLabel model
@@ -92,30 +120,47 @@ func (p *Product) GetLatestBidPrice() float64 {
 	return bidStatus.LatestBidPrice
 }
 
+// GetImages gambar product sesuai urutan, gambar primary di awal
+func (p *Product) GetImages() []ProductImage {
+	images := []ProductImage{}
+	app.DB.Where("product_id = ?", p.ID).Order("position, id").Find(&images)
+
+	return images
+}
+
+// HasDuplicateWarning product memiliki gambar duplikat yang belum diputuskan admin
+func (p *Product) HasDuplicateWarning() bool {
+	count := 0
+	app.DB.Model(&ImageDuplicateFlag{}).Where("product_id = ? AND resolved_at IS NULL", p.ID).Count(&count)
+
+	return count > 0
+}
+
 // ToAPI --
 func (p *Product) ToAPI(userID *int64) types.Product {
 
-	images := []ProductImage{}
+	images := p.GetImages()
 	labels := []ProductLabel{}
-	app.DB.Model(&p).Select("id, image_url").Related(&images).Select("name, value").Related(&labels)
+	app.DB.Model(&p).Select("name, value").Related(&labels)
 
 	bidStatus := p.GetBidderStatus(userID)
 
 	res := types.Product{
-		ID:            p.ID,
-		ProductName:   p.ProductName,
-		ProductImages: images,
-		Desc:          p.Desc,
-		Condition:     p.Condition,
-		ConditionAvg:  p.ConditionAvg,
-		StartPrice:    p.StartPrice,
-		BidMultpl:     p.BidMultpl,
-		ClosedAT:      p.ClosedAT,
-		CreatedAT:     p.CreatedAT,
-		Labels:        labels,
-		Sold:          p.Sold,
-		Closed:        p.Closed,
-		BidStatus:     bidStatus,
+		ID:               p.ID,
+		ProductName:      p.ProductName,
+		ProductImages:    images,
+		Desc:             p.Desc,
+		Condition:        p.Condition,
+		ConditionAvg:     p.ConditionAvg,
+		StartPrice:       p.StartPrice,
+		BidMultpl:        p.BidMultpl,
+		ClosedAT:         p.ClosedAT,
+		CreatedAT:        p.CreatedAT,
+		Labels:           labels,
+		Sold:             p.Sold,
+		Closed:           p.Closed,
+		BidStatus:        bidStatus,
+		DuplicateWarning: p.HasDuplicateWarning(),
 	}
 
 	return res
@@ -123,10 +168,10 @@ func (p *Product) ToAPI(userID *int64) types.Product {
 
 // ToDetailAPI product detail api type
 func (p *Product) ToDetailAPI(userID *int64) types.ProductDetail {
-	images := []ProductImage{}
+	images := p.GetImages()
 	labels := []ProductLabel{}
 
-	app.DB.Model(&p).Select("image_url").Related(&images).Select("name, value").Related(&labels)
+	app.DB.Model(&p).Select("name, value").Related(&labels)
 
 	store := Store{}
 	owner := UserSimple{}
@@ -137,21 +182,22 @@ func (p *Product) ToDetailAPI(userID *int64) types.ProductDetail {
 	bidStatus := p.GetBidderStatus(userID)
 
 	res := types.ProductDetail{
-		ID:            p.ID,
-		Store:         store,
-		ProductName:   p.ProductName,
-		ProductImages: images,
-		Desc:          p.Desc,
-		Condition:     p.Condition,
-		ConditionAvg:  p.ConditionAvg,
-		StartPrice:    p.StartPrice,
-		BidMultpl:     p.BidMultpl,
-		ClosedAT:      p.ClosedAT,
-		CreatedAT:     p.CreatedAT,
-		Labels:        labels,
-		Sold:          p.Sold,
-		Closed:        p.Closed,
-		BidStatus:     bidStatus,
+		ID:               p.ID,
+		Store:            store,
+		ProductName:      p.ProductName,
+		ProductImages:    images,
+		Desc:             p.Desc,
+		Condition:        p.Condition,
+		ConditionAvg:     p.ConditionAvg,
+		StartPrice:       p.StartPrice,
+		BidMultpl:        p.BidMultpl,
+		ClosedAT:         p.ClosedAT,
+		CreatedAT:        p.CreatedAT,
+		Labels:           labels,
+		Sold:             p.Sold,
+		Closed:           p.Closed,
+		BidStatus:        bidStatus,
+		DuplicateWarning: p.HasDuplicateWarning(),
 	}
 
 	return res
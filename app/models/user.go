@@ -9,6 +9,14 @@ import (
 
 //go:generate goqueryset -in user.go
 
+// Tipe user
+const (
+	UserTypeBidder     = 1
+	UserTypeAuctioneer = 2
+	// UserTypeAdmin user yang bisa memoderasi product
+	UserTypeAdmin = 3
+)
+
 // User definisi model untuk user
 // gen:qs
 type User struct {
@@ -22,6 +30,8 @@ type User struct {
 	Active       bool       `json:"active,omitempty"`
 	LastLogin    *time.Time `json:"last_login,omitempty"`
 	RegisteredAt time.Time  `json:"registered_at,omitempty"`
+	Locale       string     `json:"locale"`
+	LastSeenAT   *time.Time `json:"last_seen_at,omitempty"`
 }
 
 // UserSimple ...
@@ -85,12 +95,23 @@ type Store struct {
 	TS           *time.Time  `json:"ts"`
 }
 
-// UserConnect model
+// UserConnect model, satu record untuk setiap device token user
 // gen:qs
 type UserConnect struct {
-	UserID       int64  `json:"user_id"`
-	ProviderName string `json:"provider_name"`
-	AppID        string `json:"app_id"`
+	ID           int64      `json:"id"`
+	UserID       int64      `json:"user_id"`
+	ProviderName string     `json:"provider_name"`
+	AppID        string     `json:"app_id"`
+	AppVersion   string     `json:"app_version"`
+	LastSeenAT   *time.Time `json:"last_seen_at"`
+}
+
+// UserBlock model block list user, blocked tidak bisa berinteraksi dengan blocker
+// gen:qs
+type UserBlock struct {
+	BlockerID int64      `json:"blocker_id" gorm:"primary_key"`
+	BlockedID int64      `json:"blocked_id" gorm:"primary_key"`
+	CreatedAT *time.Time `json:"created_at"`
 }
 
 // TableName for UserSimple model
@@ -16,3 +16,33 @@ type UserNotif struct {
 	CreatedAT *time.Time `json:"created_at"`
 	Read      bool       `json:"read"`
 }
+
+// NotifPreference model channel yang dipilih user untuk setiap notif type
+// gen:qs
+type NotifPreference struct {
+	UserID    int64  `json:"user_id" gorm:"primary_key"`
+	NotifType int    `json:"notif_type" gorm:"primary_key"`
+	Channels  string `json:"channels"`
+}
+
+// NotifSetting model pengaturan notif user, eg: quiet hours
+// gen:qs
+type NotifSetting struct {
+	UserID      int64  `json:"user_id" gorm:"primary_key"`
+	QuietStart  string `json:"quiet_start"`
+	QuietEnd    string `json:"quiet_end"`
+	Timezone    string `json:"timezone"`
+	DailyDigest bool   `json:"daily_digest"`
+}
+
+// NotifBuffer model notif yang menunggu digabung dengan notif sejenis
+// gen:qs
+type NotifBuffer struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	NotifType int        `json:"notif_type"`
+	TargetID  int64      `json:"target_id"`
+	Data      string     `json:"data"`
+	Item      string     `json:"item"`
+	CreatedAT *time.Time `json:"created_at"`
+}
@@ -0,0 +1,67 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+//go:generate goqueryset -in webhook.go
+
+// StoreWebhook model untuk webhook subscription milik store
+// gen:qs
+type StoreWebhook struct {
+	ID           int64      `json:"id"`
+	StoreID      int64      `json:"store_id"`
+	URL          string     `json:"url"`
+	Secret       string     `json:"secret"`
+	EventTypes   string     `json:"event_types"`
+	Active       bool       `json:"active"`
+	FailureCount int        `json:"failure_count"`
+	DisabledAT   *time.Time `json:"disabled_at"`
+	CreatedAT    *time.Time `json:"created_at"`
+}
+
+// Subscribed cek apakah webhook berlangganan event type ini
+func (w *StoreWebhook) Subscribed(eventType string) bool {
+	for _, t := range strings.Split(w.EventTypes, ",") {
+		if strings.TrimSpace(t) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery model untuk log pengiriman webhook
+// gen:qs
+type WebhookDelivery struct {
+	ID             int64      `json:"id"`
+	WebhookID      int64      `json:"webhook_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         int        `json:"status"`
+	Attempts       int        `json:"attempts"`
+	LastStatusCode int        `json:"last_status_code"`
+	LastError      string     `json:"last_error"`
+	DeliveredAT    *time.Time `json:"delivered_at"`
+	CreatedAT      *time.Time `json:"created_at"`
+}
+
+// WebhookDeliveryAttempt model untuk setiap percobaan pengiriman webhook
+// gen:qs
+type WebhookDeliveryAttempt struct {
+	ID         int64      `json:"id"`
+	DeliveryID int64      `json:"delivery_id"`
+	StatusCode int        `json:"status_code"`
+	Error      string     `json:"error"`
+	DurationMs int64      `json:"duration_ms"`
+	CreatedAT  *time.Time `json:"created_at"`
+}
+
+const (
+	// DeliveryPending webhook belum berhasil dikirim dan masih akan dicoba lagi
+	DeliveryPending = iota
+	// DeliverySuccess webhook berhasil dikirim
+	DeliverySuccess
+	// DeliveryFailed webhook tetap gagal setelah max attempts
+	DeliveryFailed
+)
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/jinzhu/gorm"
+)
+
+// AuctionRepository init repo
+type AuctionRepository struct {
+	eventQs models.AuctionEventQuerySet
+}
+
+// NewAuctionRepository create instance
+func NewAuctionRepository() *AuctionRepository {
+	return &AuctionRepository{
+		eventQs: models.NewAuctionEventQuerySet(app.DB),
+	}
+}
+
+// CreateAuctionEvents simpan beberapa event lelang product dalam satu transaksi,
+// id dan created_at setiap event diisi setelah tersimpan
+func (r *AuctionRepository) CreateAuctionEvents(events []models.AuctionEvent) error {
+	now := time.Now().UTC()
+	return r.eventQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		for i := range events {
+			events[i].CreatedAT = &now
+			if err := events[i].Create(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// GetAuctionEventsAfter event lelang product setelah id tertentu, diurutkan dari yang terlama
+func (r *AuctionRepository) GetAuctionEventsAfter(productID int64, afterID int64, limit int) ([]models.AuctionEvent, error) {
+	events := []models.AuctionEvent{}
+	err := r.eventQs.ProductIDEq(productID).IDGt(afterID).OrderAscByID().Limit(limit).All(&events)
+
+	return events, err
+}
+
+// GetProductsEventsAfter event lelang beberapa product setelah id tertentu, diurutkan dari yang terlama
+func (r *AuctionRepository) GetProductsEventsAfter(productIDs []int64, afterID int64, limit int) ([]models.AuctionEvent, error) {
+	events := []models.AuctionEvent{}
+	if len(productIDs) == 0 {
+		return events, nil
+	}
+	err := r.eventQs.ProductIDIn(productIDs...).IDGt(afterID).OrderAscByID().Limit(limit).All(&events)
+
+	return events, err
+}
+
+// LastAuctionEventID id event lelang terbaru dari semua product, 0 jika belum ada event
+func (r *AuctionRepository) LastAuctionEventID() (int64, error) {
+	return lastID(r.eventQs.GetDB(), "auction_events")
+}
@@ -1,11 +1,14 @@
 package repository
 
 import (
+	"fmt"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/fatkhur1960/goauction/app"
 	"github.com/fatkhur1960/goauction/app/models"
-	"github.com/fatkhur1960/goauction/app/utils"
+	"github.com/jinzhu/gorm"
 )
 
 // ChatRepository init
@@ -17,11 +20,14 @@ type ChatRepository struct {
 
 // ChatMessageQuery --
 type ChatMessageQuery struct {
-	ChatID         int64  `json:"chat_id" binding:"required"`
-	ReceiverID     int64  `json:"receiver_id" binding:"required"`
-	Text           string `json:"text" binding:"required"`
-	AttachmentKind int    `json:"attachment_kind"`
-	AttachmentData string `json:"attachment_data"`
+	ChatID int64  `json:"chat_id" binding:"required"`
+	Text   string `json:"text"`
+	// AttachmentID id file hasil upload ke chat room yang sama
+	AttachmentID int64 `json:"attachment_id"`
+	// ReceiverID selalu diambil dari chat room, tidak bisa diisi client
+	ReceiverID int64 `json:"-"`
+	// AttachmentKind diambil dari attachment, tidak bisa diisi client
+	AttachmentKind int `json:"-"`
 }
 
 // NewChatRepository instance
@@ -33,60 +39,174 @@ func NewChatRepository() *ChatRepository {
 	}
 }
 
-// CreateChat create new chat room
+// CreateChat ambil chat room antara dua user, room baru hanya dibuat jika belum ada.
+// Satu pasang user hanya punya satu room, siapapun yang memulai
 func (r *ChatRepository) CreateChat(initiatorID int64, subscriberID int64) (models.Chat, error) {
-	chat := models.Chat{
-		InitiatorID:  initiatorID,
-		SubscriberID: subscriberID,
-		LastUpdated:  &utils.NOW,
-		TS:           &utils.NOW,
+	now := time.Now().UTC()
+	err := app.DB.Exec(`
+		INSERT INTO chats (initiator_id, subscriber_id, last_updated, ts) VALUES (?, ?, ?, ?)
+		ON CONFLICT (LEAST(initiator_id, subscriber_id), GREATEST(initiator_id, subscriber_id)) DO NOTHING`,
+		initiatorID, subscriberID, now, now).Error
+	if err != nil {
+		return models.Chat{}, err
 	}
 
-	if err := chat.Create(app.DB); err != nil {
-		return models.Chat{}, err
+	chat := models.Chat{}
+	err = r.chatQs.GetDB().
+		Where("(initiator_id = ? AND subscriber_id = ?) OR (initiator_id = ? AND subscriber_id = ?)",
+			initiatorID, subscriberID, subscriberID, initiatorID).
+		First(&chat).Error
+
+	return chat, err
+}
+
+// LinkChatProduct jadikan product sebagai konteks chat room,
+// room tetap tercatat pada semua product yang pernah dibicarakan
+func (r *ChatRepository) LinkChatProduct(chatID int64, productID int64) error {
+	return r.chatQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		err := models.NewChatQuerySet(tx).IDEq(chatID).GetUpdater().SetProductID(&productID).Update()
+		if err != nil {
+			return err
+		}
+
+		return tx.Exec(`
+			INSERT INTO chat_products (chat_id, product_id, ts) VALUES (?, ?, ?)
+			ON CONFLICT (chat_id, product_id) DO UPDATE SET ts = EXCLUDED.ts`,
+			chatID, productID, time.Now().UTC()).Error
+	})
+}
+
+// GetProductChatRooms list chat room yang membicarakan product, diurutkan dari yang terbaru
+func (r *ChatRepository) GetProductChatRooms(productID int64, offset int, limit int) ([]models.Chat, int, error) {
+	chats := []models.Chat{}
+	count := 0
+	dao := r.chatQs.GetDB().Select("chats.*").
+		Joins("JOIN chat_products ON chat_products.chat_id = chats.id").
+		Where("chat_products.product_id = ?", productID)
+	if err := dao.Model(&models.Chat{}).Count(&count).Error; err != nil {
+		return chats, count, err
 	}
+	err := dao.Order("chat_products.ts DESC").Offset(offset).Limit(limit).Find(&chats).Error
 
-	return chat, nil
+	return chats, count, err
 }
 
-// CreateChatMessage create new chat message
+// CreateChatMessage create new chat message beserta history untuk kedua participant
 func (r *ChatRepository) CreateChatMessage(senderID int64, query ChatMessageQuery) (models.Message, error) {
+	now := time.Now().UTC()
 	message := models.Message{
 		ChatID:         query.ChatID,
 		SenderID:       senderID,
 		ReceiverID:     query.ReceiverID,
 		Text:           query.Text,
 		AttachmentKind: query.AttachmentKind,
-		AttachmentData: query.AttachmentData,
+		TS:             &now,
+	}
+	if query.AttachmentID != 0 {
+		message.AttachmentID = &query.AttachmentID
 	}
-	message.Create(app.DB)
 
-	// Create chat history
-	{
-		u1History := models.ChatHistory{
-			ChatID:    query.ChatID,
-			OwnerID:   senderID,
-			MessageID: message.ID,
+	err := r.chatQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := message.Create(tx); err != nil {
+			return err
 		}
-		u1History.Create(app.DB)
 
-		u2History := models.ChatHistory{
-			ChatID:    query.ChatID,
-			OwnerID:   query.ReceiverID,
-			MessageID: message.ID,
+		// Create chat history
+		for _, ownerID := range []int64{senderID, query.ReceiverID} {
+			history := models.ChatHistory{
+				ChatID:    query.ChatID,
+				OwnerID:   ownerID,
+				MessageID: message.ID,
+			}
+			if err := history.Create(tx); err != nil {
+				return err
+			}
 		}
-		u2History.Create(app.DB)
-	}
 
-	// Update last updated time on chat room
-	{
-		updated := time.Now().UTC()
-		r.chatQs.IDEq(query.ChatID).GetUpdater().SetLastUpdated(&updated).Update()
+		// Update last updated time on chat room
+		return models.NewChatQuerySet(tx).IDEq(query.ChatID).GetUpdater().SetLastUpdated(&now).Update()
+	})
+	if err != nil {
+		return models.Message{}, err
 	}
 
 	return message, nil
 }
 
+// GetMessageByID message berdasarkan id-nya
+func (r *ChatRepository) GetMessageByID(messageID int64) (models.Message, error) {
+	message := models.Message{}
+	err := r.msgQs.IDEq(messageID).One(&message)
+
+	return message, err
+}
+
+// UpdateMessageText ubah text message dan catat waktu edit-nya
+func (r *ChatRepository) UpdateMessageText(messageID int64, text string) (models.Message, error) {
+	now := time.Now().UTC()
+	err := r.msgQs.IDEq(messageID).GetUpdater().SetText(text).SetEditedAT(&now).Update()
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	return r.GetMessageByID(messageID)
+}
+
+// TombstoneMessage hapus isi message untuk semua participant,
+// message tetap ada agar urutan dan history tidak berubah
+func (r *ChatRepository) TombstoneMessage(messageID int64) (models.Message, error) {
+	err := r.msgQs.IDEq(messageID).GetUpdater().
+		SetDeleted(true).
+		SetText("").
+		SetAttachmentKind(0).
+		SetAttachmentData("").
+		SetAttachmentID(nil).
+		Update()
+	if err != nil {
+		return models.Message{}, err
+	}
+
+	return r.GetMessageByID(messageID)
+}
+
+// DeleteChatHistory hapus message dari history user saja (delete for me),
+// false jika message tidak ada di history user
+func (r *ChatRepository) DeleteChatHistory(messageID int64, ownerID int64) (bool, error) {
+	res := r.chQs.GetDB().Where("message_id = ? AND owner_id = ?", messageID, ownerID).Delete(&models.ChatHistory{})
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// CreateAttachment simpan data file yang sudah diupload ke storage
+func (r *ChatRepository) CreateAttachment(attachment models.ChatAttachment) (models.ChatAttachment, error) {
+	now := time.Now().UTC()
+	attachment.CreatedAT = &now
+	err := attachment.Create(app.DB)
+
+	return attachment, err
+}
+
+// GetAttachmentByID attachment berdasarkan id-nya
+func (r *ChatRepository) GetAttachmentByID(attachmentID int64) (models.ChatAttachment, error) {
+	attachment := models.ChatAttachment{}
+	err := models.NewChatAttachmentQuerySet(app.DB).IDEq(attachmentID).One(&attachment)
+
+	return attachment, err
+}
+
+// DeleteAttachment hapus data attachment, file di storage harus dihapus terpisah
+func (r *ChatRepository) DeleteAttachment(attachmentID int64) error {
+	return models.NewChatAttachmentQuerySet(app.DB).IDEq(attachmentID).Delete()
+}
+
+// GetChatByID chat room berdasarkan id-nya
+func (r *ChatRepository) GetChatByID(chatID int64) (models.Chat, error) {
+	chat := models.Chat{}
+	err := r.chatQs.IDEq(chatID).One(&chat)
+
+	return chat, err
+}
+
 // GetUserChatRooms listing user chats
 func (r *ChatRepository) GetUserChatRooms(userID int64, offset int, limit int) ([]models.Chat, int, error) {
 	chats := []models.Chat{}
@@ -100,9 +220,16 @@ func (r *ChatRepository) GetUserChatRooms(userID int64, offset int, limit int) (
 
 // GetChatMessages list chat message
 func (r *ChatRepository) GetChatMessages(chatID int64, userID int64, offset int, limit int) ([]models.Message, int, error) {
+	return r.FilterChatMessages(chatID, userID, MessageSearch{}, offset, limit)
+}
+
+// FilterChatMessages list chat message yang cocok dengan text dan attachment pada search
+func (r *ChatRepository) FilterChatMessages(chatID int64, userID int64, search MessageSearch, offset int, limit int) ([]models.Message, int, error) {
 	messages := []models.Message{}
 	count := 0
-	dao := r.chQs.GetDB().Select("messages.*").Joins("JOIN messages ON messages.id = user_chat_histories.message_id").Where("user_chat_histories.chat_id = ?", chatID).Where("user_chat_histories.owner_id = ?", userID)
+	search.ChatID = chatID
+	// tabel harus dipasang eksplisit, Find ke []models.Message membuat gorm memakai tabel messages
+	dao := searchScope(r.chQs.GetDB().Table("user_chat_histories").Select("messages.*"), userID, search)
 	dao.Count(&count)
 	res := dao.Offset(offset).Limit(limit).Find(&messages)
 	if res.Error != nil {
@@ -111,3 +238,227 @@ func (r *ChatRepository) GetChatMessages(chatID int64, userID int64, offset int,
 
 	return messages, count, nil
 }
+
+// IsChatParticipant cek apakah user adalah initiator atau subscriber chat room
+func (r *ChatRepository) IsChatParticipant(chatID int64, userID int64) bool {
+	count := 0
+	r.chatQs.GetDB().Where("id = ? AND (initiator_id = ? OR subscriber_id = ?)", chatID, userID, userID).Count(&count)
+
+	return count > 0
+}
+
+// ChatReadState status baca chat room dari sisi user
+type ChatReadState struct {
+	ChatID        int64
+	Unread        int
+	PartnerReadID int64
+}
+
+// MarkChatRead majukan read pointer user pada chat room sampai messageID,
+// pointer tidak pernah mundur. messageID 0 berarti sampai message terakhir
+func (r *ChatRepository) MarkChatRead(chatID int64, userID int64, messageID int64) (models.ChatRead, error) {
+	if messageID == 0 {
+		last := models.Message{}
+		err := r.msgQs.ChatIDEq(chatID).OrderDescByID().Limit(1).One(&last)
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			return models.ChatRead{}, err
+		}
+		messageID = last.ID
+	}
+
+	err := app.DB.Exec(`
+		INSERT INTO chat_reads (chat_id, user_id, last_read_id, read_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT (chat_id, user_id) DO UPDATE
+		SET last_read_id = EXCLUDED.last_read_id, read_at = EXCLUDED.read_at
+		WHERE chat_reads.last_read_id < EXCLUDED.last_read_id`,
+		chatID, userID, messageID, time.Now().UTC()).Error
+	if err != nil {
+		return models.ChatRead{}, err
+	}
+
+	return r.GetChatRead(chatID, userID)
+}
+
+// GetChatRead read pointer user pada chat room
+func (r *ChatRepository) GetChatRead(chatID int64, userID int64) (models.ChatRead, error) {
+	read := models.ChatRead{}
+	err := models.NewChatReadQuerySet(app.DB).ChatIDEq(chatID).UserIDEq(userID).One(&read)
+	if gorm.IsRecordNotFoundError(err) {
+		return models.ChatRead{ChatID: chatID, UserID: userID}, nil
+	}
+
+	return read, err
+}
+
+// GetChatReadStates jumlah message belum dibaca dan read pointer lawan chat untuk setiap chat room
+func (r *ChatRepository) GetChatReadStates(userID int64, chatIDs []int64) (map[int64]ChatReadState, error) {
+	states := map[int64]ChatReadState{}
+	if len(chatIDs) == 0 {
+		return states, nil
+	}
+
+	rows, err := app.DB.Raw(`
+		SELECT c.id,
+			(SELECT COUNT(*) FROM messages m
+				JOIN user_chat_histories h ON h.message_id = m.id AND h.owner_id = m.receiver_id
+				WHERE m.chat_id = c.id AND m.receiver_id = ? AND NOT m.deleted
+				AND m.id > COALESCE((SELECT last_read_id FROM chat_reads WHERE chat_id = c.id AND user_id = ?), 0)),
+			COALESCE((SELECT MAX(last_read_id) FROM chat_reads WHERE chat_id = c.id AND user_id <> ?), 0)
+		FROM chats c WHERE c.id IN (?)`, userID, userID, userID, chatIDs).Rows()
+	if err != nil {
+		return states, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		state := ChatReadState{}
+		if err := rows.Scan(&state.ChatID, &state.Unread, &state.PartnerReadID); err != nil {
+			return states, err
+		}
+		states[state.ChatID] = state
+	}
+
+	return states, rows.Err()
+}
+
+// GetChatPartnerIDs semua user yang punya chat room dengan user
+func (r *ChatRepository) GetChatPartnerIDs(userID int64) ([]int64, error) {
+	ids := []int64{}
+	rows, err := app.DB.Raw(`
+		SELECT DISTINCT CASE WHEN initiator_id = ? THEN subscriber_id ELSE initiator_id END
+		FROM chats WHERE initiator_id = ? OR subscriber_id = ?`, userID, userID, userID).Rows()
+	if err != nil {
+		return ids, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// GetUserMessagesAfter message pada semua chat room user setelah id tertentu, diurutkan dari yang terlama
+func (r *ChatRepository) GetUserMessagesAfter(userID int64, afterID int64, limit int) ([]models.Message, error) {
+	messages := []models.Message{}
+	err := r.chQs.GetDB().Table("user_chat_histories").Select("messages.*").
+		Joins("JOIN messages ON messages.id = user_chat_histories.message_id").
+		Where("user_chat_histories.owner_id = ? AND messages.id > ?", userID, afterID).
+		Order("messages.id ASC").Limit(limit).Find(&messages).Error
+
+	return messages, err
+}
+
+// GetUserChatIDs id semua chat room user
+func (r *ChatRepository) GetUserChatIDs(userID int64) ([]int64, error) {
+	ids := []int64{}
+	err := r.chatQs.GetDB().Model(&models.Chat{}).
+		Where("initiator_id = ? OR subscriber_id = ?", userID, userID).Pluck("id", &ids).Error
+
+	return ids, err
+}
+
+// LastMessageID id message terbaru dari semua chat room, 0 jika belum ada message
+func (r *ChatRepository) LastMessageID() (int64, error) {
+	return lastID(r.msgQs.GetDB(), "messages")
+}
+
+// chatSearchConfig text search configuration untuk message, lihat migration chat_search
+const chatSearchConfig = "public.chat_search"
+
+// markStart dan markStop penanda kata yang cocok dari ts_headline, karakter kontrol ini dibuang
+// dari text message sebelum ts_headline sehingga hanya server yang bisa membuat penanda
+const (
+	markStart = "\x02"
+	markStop  = "\x03"
+)
+
+// chatHeadlineOptions opsi ts_headline untuk snippet hasil pencarian
+var chatHeadlineOptions = fmt.Sprintf("StartSel=\"%s\", StopSel=\"%s\", MaxWords=25, MinWords=8, MaxFragments=2, FragmentDelimiter=\" ... \"", markStart, markStop)
+
+// snippetReplacer ganti penanda dari ts_headline dengan tag <mark> setelah snippet di-escape
+var snippetReplacer = strings.NewReplacer(markStart, "<mark>", markStop, "</mark>")
+
+// MessageSearch filter pencarian message pada history user, field kosong berarti tidak difilter
+type MessageSearch struct {
+	// Query kata yang dicari pada text message
+	Query    string
+	ChatID   int64
+	SenderID int64
+	// Since dan Until batas waktu message, Until eksklusif
+	Since *time.Time
+	Until *time.Time
+	// AttachmentKinds jenis attachment message
+	AttachmentKinds []int
+	// Before cursor, hanya message dengan id lebih kecil
+	Before int64
+}
+
+// MessageHit message hasil pencarian beserta snippet text yang sudah di-escape html,
+// kata yang cocok ditandai dengan <mark>
+type MessageHit struct {
+	models.Message
+	Snippet string `json:"snippet"`
+}
+
+// SearchMessages cari message pada history user sendiri, diurutkan dari yang terbaru.
+// Message yang dihapus dari history user atau dihapus untuk semua participant tidak ikut dicari
+func (r *ChatRepository) SearchMessages(userID int64, search MessageSearch, limit int) ([]MessageHit, error) {
+	hits := []MessageHit{}
+	// tabel harus dipasang eksplisit, Find ke []MessageHit membuat gorm mencari tabel message_hits
+	dao := r.chQs.GetDB().Table("user_chat_histories")
+	text := "REPLACE(REPLACE(messages.text, ?, ''), ?, '')"
+	if search.Query != "" {
+		dao = dao.Select("messages.*, ts_headline(?::regconfig, "+text+", plainto_tsquery(?::regconfig, ?), ?) AS snippet",
+			chatSearchConfig, markStart, markStop, chatSearchConfig, search.Query, chatHeadlineOptions)
+	} else {
+		dao = dao.Select("messages.*, "+text+" AS snippet", markStart, markStop)
+	}
+	if search.Before > 0 {
+		dao = dao.Where("messages.id < ?", search.Before)
+	}
+
+	err := searchScope(dao, userID, search).
+		Where("NOT messages.deleted").
+		Order("messages.id DESC").
+		Limit(limit).
+		Find(&hits).Error
+
+	for i := range hits {
+		hits[i].Snippet = snippetReplacer.Replace(html.EscapeString(hits[i].Snippet))
+	}
+
+	return hits, err
+}
+
+// searchScope batasi query user_chat_histories pada message milik user yang cocok dengan search
+func searchScope(dao *gorm.DB, userID int64, search MessageSearch) *gorm.DB {
+	dao = dao.Joins("JOIN messages ON messages.id = user_chat_histories.message_id").
+		Where("user_chat_histories.owner_id = ?", userID)
+
+	if search.Query != "" {
+		dao = dao.Where("messages.search_vector @@ plainto_tsquery(?::regconfig, ?)", chatSearchConfig, search.Query)
+	}
+	if search.ChatID != 0 {
+		dao = dao.Where("user_chat_histories.chat_id = ?", search.ChatID)
+	}
+	if search.SenderID != 0 {
+		dao = dao.Where("messages.sender_id = ?", search.SenderID)
+	}
+	if search.Since != nil {
+		dao = dao.Where("messages.ts >= ?", search.Since.UTC())
+	}
+	if search.Until != nil {
+		dao = dao.Where("messages.ts < ?", search.Until.UTC())
+	}
+	if len(search.AttachmentKinds) > 0 {
+		dao = dao.Where("messages.attachment_kind IN (?)", search.AttachmentKinds)
+	}
+
+	return dao
+}
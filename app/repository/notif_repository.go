@@ -1,55 +1,100 @@
 package repository
 
 import (
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/fatkhur1960/goauction/app"
 	"github.com/fatkhur1960/goauction/app/models"
-	"github.com/fatkhur1960/goauction/app/utils"
 	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/jinzhu/gorm"
 )
 
-// NotifRepository init repo
-type NotifRepository struct {
-	NotifQs models.UserNotifQuerySet
-}
+type (
+	// NotifRepository init repo
+	NotifRepository struct {
+		NotifQs      models.UserNotifQuerySet
+		preferenceQs models.NotifPreferenceQuerySet
+		settingQs    models.NotifSettingQuerySet
+	}
+
+	// NotifFilter filter list notif, nil berarti tidak difilter
+	NotifFilter struct {
+		NotifType *int
+		Read      *bool
+	}
+)
 
 // NewNotifRepository create instance
 func NewNotifRepository() *NotifRepository {
 	return &NotifRepository{
-		NotifQs: models.NewUserNotifQuerySet(app.DB),
+		NotifQs:      models.NewUserNotifQuerySet(app.DB),
+		preferenceQs: models.NewNotifPreferenceQuerySet(app.DB),
+		settingQs:    models.NewNotifSettingQuerySet(app.DB),
 	}
 }
 
 // CreateNotif create user notification
 func (n *NotifRepository) CreateNotif(targetUser int64, title string, content string, notifType core.NotifType, targetID int64) (models.UserNotif, error) {
+	return n.CreateNotifTx(app.DB, targetUser, title, content, notifType, targetID)
+}
+
+// CreateNotifTx create user notification using the given transaction
+func (n *NotifRepository) CreateNotifTx(tx *gorm.DB, targetUser int64, title string, content string, notifType core.NotifType, targetID int64) (models.UserNotif, error) {
+	// waktu dibuat dipakai oleh retention job dan urutan cursor, harus waktu saat notif dibuat
+	now := time.Now().UTC()
 	notif := models.UserNotif{
 		UserID:    targetUser,
 		Title:     title,
 		Content:   content,
 		NotifType: int(notifType),
 		Target:    int(targetID),
-		CreatedAT: &utils.NOW,
+		CreatedAT: &now,
 	}
 
-	if err := notif.Create(app.DB); err != nil {
+	if err := notif.Create(tx); err != nil {
 		return models.UserNotif{}, err
 	}
 
 	return notif, nil
 }
 
-// GetUserNotif list user notification
-func (n *NotifRepository) GetUserNotif(userID int64, offset int, limit int) ([]models.UserNotif, int, error) {
+// GetUserNotifs list notif user dari yang terbaru, before adalah cursor berupa id notif terakhir
+// dari halaman sebelumnya, 0 untuk halaman pertama
+func (n *NotifRepository) GetUserNotifs(userID int64, filter NotifFilter, before int64, limit int) ([]models.UserNotif, error) {
 	notifs := []models.UserNotif{}
 	dao := n.NotifQs.UserIDEq(userID)
-	err := dao.Offset(offset).Limit(limit).All(&notifs)
-	if err != nil {
-		return notifs, 0, nil
+	if before > 0 {
+		dao = dao.IDLt(before)
 	}
-	count, err := dao.Count()
-	if err != nil {
-		return notifs, 0, nil
+	if filter.NotifType != nil {
+		dao = dao.NotifTypeEq(*filter.NotifType)
+	}
+	if filter.Read != nil {
+		dao = dao.ReadEq(*filter.Read)
 	}
-	return notifs, count, nil
+
+	err := dao.OrderDescByID().Limit(limit).All(&notifs)
+	return notifs, err
+}
+
+// GetUserNotifsAfter notif user setelah id tertentu, diurutkan dari yang terlama
+func (n *NotifRepository) GetUserNotifsAfter(userID int64, afterID int64, limit int) ([]models.UserNotif, error) {
+	notifs := []models.UserNotif{}
+	err := n.NotifQs.UserIDEq(userID).IDGt(afterID).OrderAscByID().Limit(limit).All(&notifs)
+
+	return notifs, err
+}
+
+// LastNotifID id notif terbaru dari semua user, 0 jika belum ada notif
+func (n *NotifRepository) LastNotifID() (int64, error) {
+	return lastID(n.NotifQs.GetDB(), "user_notifs")
+}
+
+// CountUnread jumlah notif yang belum dibaca user
+func (n *NotifRepository) CountUnread(userID int64) (int, error) {
+	return n.NotifQs.UserIDEq(userID).ReadEq(false).Count()
 }
 
 // MarkAsRead mark as read notif
@@ -60,3 +105,121 @@ func (n *NotifRepository) MarkAsRead(ids []int64, userID int64) error {
 	}
 	return nil
 }
+
+// MarkAllAsRead tandai semua notif user sudah dibaca
+func (n *NotifRepository) MarkAllAsRead(userID int64) error {
+	return n.NotifQs.UserIDEq(userID).ReadEq(false).GetUpdater().SetRead(true).Update()
+}
+
+// DeleteNotifs hapus notif milik user
+func (n *NotifRepository) DeleteNotifs(ids []int64, userID int64) error {
+	return n.NotifQs.IDIn(ids...).UserIDEq(userID).Delete()
+}
+
+// PruneReadNotifs hapus notif yang sudah dibaca dan dibuat sebelum waktu tertentu
+func (n *NotifRepository) PruneReadNotifs(before time.Time) (int64, error) {
+	return n.NotifQs.ReadEq(true).CreatedATLt(before).DeleteNum()
+}
+
+// GetPreferences semua preferensi channel milik user
+func (n *NotifRepository) GetPreferences(userID int64) ([]models.NotifPreference, error) {
+	preferences := []models.NotifPreference{}
+	err := n.preferenceQs.UserIDEq(userID).OrderAscByNotifType().All(&preferences)
+
+	return preferences, err
+}
+
+// GetPreference preferensi channel user untuk satu notif type
+func (n *NotifRepository) GetPreference(userID int64, notifType core.NotifType) (models.NotifPreference, error) {
+	preference := models.NotifPreference{}
+	err := n.preferenceQs.UserIDEq(userID).NotifTypeEq(int(notifType)).One(&preference)
+
+	return preference, err
+}
+
+// SetPreference menyimpan channel yang dipilih user untuk notif type
+func (n *NotifRepository) SetPreference(userID int64, notifType core.NotifType, channels []string) error {
+	return app.DB.Exec(`
+		INSERT INTO notif_preferences (user_id, notif_type, channels) VALUES (?, ?, ?)
+		ON CONFLICT (user_id, notif_type) DO UPDATE SET channels = EXCLUDED.channels`,
+		userID, int(notifType), strings.Join(channels, ","),
+	).Error
+}
+
+// GetSetting pengaturan notif user
+func (n *NotifRepository) GetSetting(userID int64) (models.NotifSetting, error) {
+	setting := models.NotifSetting{}
+	err := n.settingQs.UserIDEq(userID).One(&setting)
+
+	return setting, err
+}
+
+// SetQuietHours menyimpan quiet hours user, start dan end kosong untuk menonaktifkan
+func (n *NotifRepository) SetQuietHours(userID int64, start string, end string, timezone string) error {
+	return app.DB.Exec(`
+		INSERT INTO notif_settings (user_id, quiet_start, quiet_end, timezone) VALUES (?, ?, ?, ?)
+		ON CONFLICT (user_id) DO UPDATE
+		SET quiet_start = EXCLUDED.quiet_start, quiet_end = EXCLUDED.quiet_end, timezone = EXCLUDED.timezone`,
+		userID, start, end, timezone,
+	).Error
+}
+
+// SetDailyDigest aktifkan atau nonaktifkan email ringkasan harian
+func (n *NotifRepository) SetDailyDigest(userID int64, enabled bool) error {
+	return app.DB.Exec(`
+		INSERT INTO notif_settings (user_id, daily_digest) VALUES (?, ?)
+		ON CONFLICT (user_id) DO UPDATE SET daily_digest = EXCLUDED.daily_digest`,
+		userID, enabled,
+	).Error
+}
+
+// GetDigestUserIDs user yang memilih menerima email ringkasan harian
+func (n *NotifRepository) GetDigestUserIDs() ([]int64, error) {
+	settings := []models.NotifSetting{}
+	if err := n.settingQs.DailyDigestEq(true).Select(models.NotifSettingDBSchema.UserID).All(&settings); err != nil {
+		return nil, err
+	}
+
+	ids := []int64{}
+	for _, setting := range settings {
+		ids = append(ids, setting.UserID)
+	}
+	return ids, nil
+}
+
+// BufferNotif simpan notif yang akan digabung dengan notif sejenis
+func (n *NotifRepository) BufferNotif(userID int64, notifType core.NotifType, targetID int64, data string, item string) error {
+	now := time.Now().UTC()
+	buffer := models.NotifBuffer{
+		UserID:    userID,
+		NotifType: int(notifType),
+		TargetID:  targetID,
+		Data:      data,
+		Item:      item,
+		CreatedAT: &now,
+	}
+
+	return buffer.Create(app.DB)
+}
+
+// TakeBufferedNotifs ambil lalu hapus notif yang menunggu digabung, diurutkan dari yang terlama.
+// onTaken dijalankan dalam transaksi yang sama jika ada notif yang diambil,
+// buffer dikembalikan jika onTaken gagal
+func (n *NotifRepository) TakeBufferedNotifs(userID int64, notifType core.NotifType, targetID int64, onTaken func(tx *gorm.DB, buffers []models.NotifBuffer) error) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		buffers := []models.NotifBuffer{}
+		err := tx.Raw(`
+			DELETE FROM notif_buffers WHERE user_id = ? AND notif_type = ? AND target_id = ?
+			RETURNING *`,
+			userID, int(notifType), targetID,
+		).Scan(&buffers).Error
+		if err != nil || len(buffers) == 0 {
+			return err
+		}
+
+		sort.Slice(buffers, func(i, j int) bool {
+			return buffers[i].ID < buffers[j].ID
+		})
+		return onTaken(tx, buffers)
+	})
+}
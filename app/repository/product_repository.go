@@ -13,6 +13,12 @@ import (
 	"github.com/jinzhu/gorm"
 )
 
+// MaxProductImages jumlah maksimal gambar untuk satu product
+const MaxProductImages = 10
+
+// ErrInvalidProductImages gambar tidak ditemukan, bukan milik owner atau sudah dipakai product lain
+var ErrInvalidProductImages = errors.New("Gambar product tidak valid, upload gambar terlebih dahulu")
+
 type (
 	// ProductRepository init repo
 	ProductRepository struct {
@@ -21,6 +27,7 @@ type (
 		imageQs   models.ProductImageQuerySet
 		labelQs   models.ProductLabelQuerySet
 		bidderQs  models.ProductBidderQuerySet
+		flagQs    models.ImageDuplicateFlagQuerySet
 	}
 
 	// LabelQuery definisi query untuk product label
@@ -31,30 +38,34 @@ type (
 
 	// NewProductQuery definisi query untuk menambahkan product
 	NewProductQuery struct {
-		StoreID       int64        `json:"store_id" binding:"required"`
-		ProductName   string       `json:"product_name" binding:"required"`
-		ProductImages []string     `json:"product_images"  binding:"required"`
-		Desc          string       `json:"desc"  binding:"required"`
-		Condition     int32        `json:"condition"  binding:"required"`
-		ConditionAvg  float64      `json:"condition_avg" binding:"required"`
-		StartPrice    float64      `json:"start_price" binding:"required"`
-		BidMultpl     float64      `json:"bid_multpl" binding:"required"`
-		ClosedAT      string       `json:"closed_at" binding:"required"`
-		Labels        []LabelQuery `json:"labels" binding:"required"`
+		StoreID      int64        `json:"store_id" binding:"required"`
+		ProductName  string       `json:"product_name" binding:"required"`
+		ImageIDs     []int64      `json:"image_ids" binding:"required"`
+		Desc         string       `json:"desc"  binding:"required"`
+		Condition    int32        `json:"condition"  binding:"required"`
+		ConditionAvg float64      `json:"condition_avg" binding:"required"`
+		StartPrice   float64      `json:"start_price" binding:"required"`
+		BidMultpl    float64      `json:"bid_multpl" binding:"required"`
+		ClosedAT     string       `json:"closed_at" binding:"required"`
+		Labels       []LabelQuery `json:"labels" binding:"required"`
+		// OwnerID pemilik store, hanya gambar yang diupload owner yang bisa dipasang
+		OwnerID int64 `json:"-"`
 	}
 
 	// UpdateProductQuery definisi query untuk menambahkan product
 	UpdateProductQuery struct {
-		ID            int64        `json:"id" binding:"required"`
-		ProductName   string       `json:"product_name" binding:"required"`
-		ProductImages []string     `json:"product_images"  binding:"required"`
-		Desc          string       `json:"desc"  binding:"required"`
-		Condition     int32        `json:"condition"  binding:"required"`
-		ConditionAvg  float64      `json:"condition_avg" binding:"required"`
-		StartPrice    float64      `json:"start_price" binding:"required"`
-		BidMultpl     float64      `json:"bid_multpl" binding:"required"`
-		ClosedAT      string       `json:"closed_at" binding:"required"`
-		Labels        []LabelQuery `json:"labels" binding:"required"`
+		ID           int64        `json:"id" binding:"required"`
+		ProductName  string       `json:"product_name" binding:"required"`
+		ImageIDs     []int64      `json:"image_ids" binding:"required"`
+		Desc         string       `json:"desc"  binding:"required"`
+		Condition    int32        `json:"condition"  binding:"required"`
+		ConditionAvg float64      `json:"condition_avg" binding:"required"`
+		StartPrice   float64      `json:"start_price" binding:"required"`
+		BidMultpl    float64      `json:"bid_multpl" binding:"required"`
+		ClosedAT     string       `json:"closed_at" binding:"required"`
+		Labels       []LabelQuery `json:"labels" binding:"required"`
+		// OwnerID pemilik store, hanya gambar yang diupload owner yang bisa dipasang
+		OwnerID int64 `json:"-"`
 	}
 
 	// ProductFilter definisi type untuk filter product
@@ -76,6 +87,7 @@ func NewProductRepository() *ProductRepository {
 		imageQs:   models.NewProductImageQuerySet(app.DB),
 		labelQs:   models.NewProductLabelQuerySet(app.DB),
 		bidderQs:  models.NewProductBidderQuerySet(app.DB),
+		flagQs:    models.NewImageDuplicateFlagQuerySet(app.DB),
 	}
 }
 
@@ -102,12 +114,8 @@ func (s *ProductRepository) CreateProduct(query NewProductQuery) (models.Product
 			return errors.New("Tidak dapat menambahkan produk")
 		}
 
-		for _, url := range query.ProductImages {
-			image := models.ProductImage{
-				ProductID: product.ID,
-				ImageURL:  url,
-			}
-			tx.Create(&image)
+		if _, err := attachImages(tx, product.ID, query.OwnerID, query.ImageIDs); err != nil {
+			return err
 		}
 
 		for _, label := range query.Labels {
@@ -219,62 +227,133 @@ func (s *ProductRepository) GetByID(productID int64) (models.Product, error) {
 	return product, nil
 }
 
-// UpdateProduct method untuk mengupdate product
-func (s *ProductRepository) UpdateProduct(productID int64, query UpdateProductQuery) (models.Product, error) {
+// UpdateProduct method untuk mengupdate product beserta label dan gambarnya dalam satu transaksi,
+// gambar yang dilepas dikembalikan agar file-nya dihapus dari storage setelah transaksi berhasil
+func (s *ProductRepository) UpdateProduct(productID int64, query UpdateProductQuery) (models.Product, []models.ProductImage, error) {
 	product := models.Product{}
-	dao := s.productQs.IDEq(productID)
-	updater := dao.GetUpdater()
-
-	closedTime, _ := time.Parse(time.RFC3339, query.ClosedAT)
+	removed := []models.ProductImage{}
+	closedTime, timeErr := time.Parse(time.RFC3339, query.ClosedAT)
+	if timeErr != nil {
+		return product, removed, errors.New("Invalid datetime format. Correct format is like " + time.RFC3339)
+	}
 
-	updater.SetProductName(query.ProductName)
-	updater.SetDesc(query.Desc)
-	updater.SetStartPrice(query.StartPrice)
-	updater.SetBidMultpl(query.BidMultpl)
-	updater.SetCondition(query.Condition)
-	updater.SetConditionAvg(query.ConditionAvg)
-	updater.SetClosedAT(&closedTime)
-	updater.Update()
+	err := s.productQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		err := models.NewProductQuerySet(tx).IDEq(productID).GetUpdater().
+			SetProductName(query.ProductName).
+			SetDesc(query.Desc).
+			SetStartPrice(query.StartPrice).
+			SetBidMultpl(query.BidMultpl).
+			SetCondition(query.Condition).
+			SetConditionAvg(query.ConditionAvg).
+			SetClosedAT(&closedTime).
+			Update()
+		if err != nil {
+			return err
+		}
 
-	s.imageQs.ProductIDEq(productID).Delete()
-	for _, url := range query.ProductImages {
-		image := models.ProductImage{
-			ProductID: productID,
-			ImageURL:  url,
+		if removed, err = attachImages(tx, productID, query.OwnerID, query.ImageIDs); err != nil {
+			return err
 		}
-		image.Create(app.DB)
-	}
 
-	s.labelQs.ProductIDEq(productID).Delete()
-	for _, label := range query.Labels {
-		label := models.ProductLabel{
-			ProductID: productID,
-			Name:      label.Name,
-			Value:     label.Value,
+		if err := models.NewProductLabelQuerySet(tx).ProductIDEq(productID).Delete(); err != nil {
+			return err
 		}
-		label.Create(app.DB)
+		for _, label := range query.Labels {
+			label := models.ProductLabel{
+				ProductID: productID,
+				Name:      label.Name,
+				Value:     label.Value,
+			}
+			if err := label.Create(tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return product, []models.ProductImage{}, err
 	}
 
-	dao.One(&product)
+	err = s.productQs.IDEq(productID).One(&product)
 
-	return product, nil
+	return product, removed, err
 }
 
-// AddProductBidder digunakan untuk menyimpan user bid product
-func (s *ProductRepository) AddProductBidder(userID int64, productID int64, bidPrice float64) (interface{}, error) {
+// AddProductBidder digunakan untuk menyimpan user bid product,
+// onCreated dijalankan dalam transaksi yang sama, misalnya untuk mencatat event
+func (s *ProductRepository) AddProductBidder(userID int64, productID int64, bidPrice float64, onCreated func(tx *gorm.DB, bidder models.ProductBidder) error) (models.ProductBidder, error) {
 	bidder := models.ProductBidder{
 		UserID:    userID,
 		ProductID: productID,
 		BidPrice:  bidPrice,
 	}
 
-	if err := bidder.Create(app.DB); err != nil {
-		return nil, err
+	err := s.bidderQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		if err := bidder.Create(tx); err != nil {
+			return err
+		}
+
+		if onCreated != nil {
+			return onCreated(tx, bidder)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.ProductBidder{}, err
 	}
 
 	return bidder, nil
 }
 
+// GetPreviousBid bid terakhir sebelum bid dengan id tertentu pada product yang sama,
+// digunakan untuk mencari bidder yang baru saja dilampaui
+func (s *ProductRepository) GetPreviousBid(productID int64, bidID int64) (models.ProductBidder, error) {
+	bidder := models.ProductBidder{}
+	err := s.bidderQs.ProductIDEq(productID).IDLt(bidID).OrderDescByID().One(&bidder)
+
+	return bidder, err
+}
+
+// CountBidsReceived jumlah bid untuk product milik store user dalam rentang waktu
+func (s *ProductRepository) CountBidsReceived(ownerID int64, since time.Time, until time.Time) (int, error) {
+	count := 0
+	err := s.bidderQs.GetDB().
+		Joins("JOIN products ON products.id = product_bidders.product_id").
+		Joins("JOIN stores ON stores.id = products.store_id").
+		Where("stores.owner_id = ? AND product_bidders.created_at >= ? AND product_bidders.created_at < ?", ownerID, since, until).
+		Count(&count).Error
+
+	return count, err
+}
+
+// CountBidsMade jumlah bid yang dibuat user dalam rentang waktu
+func (s *ProductRepository) CountBidsMade(userID int64, since time.Time, until time.Time) (int, error) {
+	return s.bidderQs.UserIDEq(userID).CreatedATGte(since).CreatedATLt(until).Count()
+}
+
+// GetWonProducts product yang ditutup dalam rentang waktu dengan user sebagai bidder terakhir
+func (s *ProductRepository) GetWonProducts(userID int64, since time.Time, until time.Time) ([]models.Product, error) {
+	products := []models.Product{}
+	latestUserID := s.bidderQs.GetDB().Select("user_id").Where("product_id = products.id").Order("id DESC").Limit(1)
+	err := s.productQs.GetDB().
+		Where("closed = ? AND closed_at >= ? AND closed_at < ? AND ? = ?", true, since, until, latestUserID.SubQuery(), userID).
+		Order("closed_at").Find(&products).Error
+
+	return products, err
+}
+
+// GetClosingBidProducts product yang masih dibuka, pernah dibid user dan ditutup sebelum until
+func (s *ProductRepository) GetClosingBidProducts(userID int64, until time.Time) ([]models.Product, error) {
+	products := []models.Product{}
+	bid := s.bidderQs.GetDB().Select("product_id").Where("user_id = ?", userID)
+	err := s.productQs.GetDB().
+		Where("closed = ? AND closed_at < ? AND id IN ?", false, until, bid.SubQuery()).
+		Order("closed_at").Find(&products).Error
+
+	return products, err
+}
+
 // DeleteProduct digunakan untuk menghapus product
 func (s *ProductRepository) DeleteProduct(productID int64, storeID int64) error {
 	s.labelQs.ProductIDEq(productID).Delete()
@@ -301,21 +380,49 @@ func (s *ProductRepository) ReOpenBid(productID int64, closedAt string) (models.
 	return product, nil
 }
 
-// CloseProduct digunakan untuk menutup lelang produk
-func (s *ProductRepository) CloseProduct(productID int64) error {
-	err := s.productQs.IDEq(productID).GetUpdater().SetClosed(true).Update()
+// CloseProduct digunakan untuk menutup lelang produk,
+// mengembalikan true hanya jika product ditutup oleh pemanggilan ini.
+// onClosed dijalankan dalam transaksi yang sama hanya jika product berhasil ditutup
+func (s *ProductRepository) CloseProduct(productID int64, onClosed func(tx *gorm.DB) error) (bool, error) {
+	closed := false
+	err := s.productQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		affected, err := models.NewProductQuerySet(tx).IDEq(productID).ClosedEq(false).GetUpdater().SetClosed(true).UpdateNum()
+		if err != nil {
+			return err
+		}
+
+		closed = affected == 1
+		if closed && onClosed != nil {
+			return onClosed(tx)
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	return nil
+	return closed, nil
 }
 
-// SetProductSold digunakan untuk menandai produk sudah terjual
-func (s *ProductRepository) SetProductSold(productID int64) (models.Product, error) {
+// SetProductSold digunakan untuk menandai produk sudah terjual,
+// onSold dijalankan dalam transaksi yang sama
+func (s *ProductRepository) SetProductSold(productID int64, onSold func(tx *gorm.DB, product models.Product) error) (models.Product, error) {
 	product := models.Product{}
 	dao := s.productQs.IDEq(productID)
-	err := dao.GetUpdater().SetSold(true).Update()
+	err := s.productQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		qs := models.NewProductQuerySet(tx).IDEq(productID)
+		if err := qs.GetUpdater().SetSold(true).Update(); err != nil {
+			return err
+		}
+
+		if onSold != nil {
+			if err := qs.One(&product); err != nil {
+				return err
+			}
+			return onSold(tx, product)
+		}
+		return nil
+	})
 	if err != nil {
 		return product, err
 	}
@@ -336,3 +443,148 @@ func (s *ProductRepository) CleanUpProduct() {
 		}
 	}
 }
+
+// CreateProductImage simpan gambar hasil upload yang belum dipasang ke product
+func (s *ProductRepository) CreateProductImage(image models.ProductImage) (models.ProductImage, error) {
+	now := time.Now().UTC()
+	image.CreatedAT = &now
+	err := image.Create(app.DB)
+
+	return image, err
+}
+
+// GetProductImage get gambar product by id
+func (s *ProductRepository) GetProductImage(imageID int64) (models.ProductImage, error) {
+	image := models.ProductImage{}
+	err := s.imageQs.IDEq(imageID).One(&image)
+
+	return image, err
+}
+
+// GetProductImages gambar product sesuai urutan
+func (s *ProductRepository) GetProductImages(productID int64) ([]models.ProductImage, error) {
+	images := []models.ProductImage{}
+	err := s.imageQs.GetDB().Where("product_id = ?", productID).Order("position, id").Find(&images).Error
+
+	return images, err
+}
+
+// DeletePendingProductImage hapus gambar yang belum dipasang ke product,
+// false jika gambar sudah dipasang atau tidak ada
+func (s *ProductRepository) DeletePendingProductImage(imageID int64) (bool, error) {
+	res := app.DB.Exec(`DELETE FROM product_images WHERE id = ? AND product_id IS NULL`, imageID)
+
+	return res.RowsAffected > 0, res.Error
+}
+
+// ReplaceProductImages ganti gambar product sesuai urutan imageIDs, gambar yang tidak ada
+// di imageIDs dihapus dan dikembalikan agar file-nya bisa dihapus dari storage
+func (s *ProductRepository) ReplaceProductImages(productID int64, ownerID int64, imageIDs []int64) ([]models.ProductImage, error) {
+	removed := []models.ProductImage{}
+	err := s.imageQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		var err error
+		removed, err = attachImages(tx, productID, ownerID, imageIDs)
+		return err
+	})
+
+	return removed, err
+}
+
+// attachImages pasang gambar ke product sesuai urutan, gambar pertama menjadi primary.
+// Gambar harus milik product itu sendiri atau hasil upload owner yang belum dipasang
+func attachImages(tx *gorm.DB, productID int64, ownerID int64, imageIDs []int64) ([]models.ProductImage, error) {
+	removed := []models.ProductImage{}
+	if len(imageIDs) == 0 || len(imageIDs) > MaxProductImages {
+		return removed, fmt.Errorf("Jumlah gambar product harus 1 sampai %d", MaxProductImages)
+	}
+
+	seen := map[int64]bool{}
+	for position, imageID := range imageIDs {
+		if seen[imageID] {
+			return removed, ErrInvalidProductImages
+		}
+		seen[imageID] = true
+
+		res := tx.Exec(`
+			UPDATE product_images SET product_id = ?, position = ?, is_primary = ?
+			WHERE id = ? AND (product_id = ? OR (product_id IS NULL AND uploader_id = ?))`,
+			productID, position, position == 0, imageID, productID, ownerID)
+		if res.Error != nil {
+			return removed, res.Error
+		} else if res.RowsAffected != 1 {
+			return removed, ErrInvalidProductImages
+		}
+	}
+
+	err := tx.Where("product_id = ? AND id NOT IN (?)", productID, imageIDs).Find(&removed).Error
+	if err != nil || len(removed) == 0 {
+		return removed, err
+	}
+
+	return removed, tx.Where("product_id = ? AND id NOT IN (?)", productID, imageIDs).Delete(&models.ProductImage{}).Error
+}
+
+// phashBandCount jumlah band pada index phash_bands, lihat migrasi image_hash_bands
+const phashBandCount = 7
+
+// FlagDuplicateImages bandingkan hash gambar product dengan gambar product milik store lain,
+// pasangan dengan jarak hamming maksimal maxDistance diflag untuk dimoderasi admin.
+// Kandidat diambil dari index phash_bands sehingga maxDistance harus lebih kecil dari phashBandCount.
+// Pasangan yang sudah pernah diflag tidak diflag ulang, mengembalikan jumlah flag baru
+func (s *ProductRepository) FlagDuplicateImages(productID int64, maxDistance int) (int64, error) {
+	if maxDistance >= phashBandCount {
+		return 0, fmt.Errorf("max distance %d is not covered by %d hash bands", maxDistance, phashBandCount)
+	}
+
+	res := app.DB.Exec(`
+		INSERT INTO image_duplicate_flags (product_id, image_id, matched_product_id, matched_image_id, distance, created_at)
+		SELECT pi.product_id, pi.id, mi.product_id, mi.id, d.distance, ?
+		FROM product_images pi
+		JOIN products p ON p.id = pi.product_id
+		JOIN product_images mi ON mi.phash IS NOT NULL AND phash_bands(mi.phash) && phash_bands(pi.phash)
+			AND mi.product_id <> pi.product_id
+		JOIN products mp ON mp.id = mi.product_id AND mp.store_id <> p.store_id
+		CROSS JOIN LATERAL (
+			SELECT LENGTH(REPLACE((pi.phash # mi.phash)::BIT(64)::TEXT, '0', '')) AS distance
+		) d
+		WHERE pi.product_id = ? AND pi.phash IS NOT NULL AND d.distance <= ?
+		ON CONFLICT (image_id, matched_image_id) DO NOTHING`,
+		time.Now().UTC(), productID, maxDistance)
+
+	return res.RowsAffected, res.Error
+}
+
+// GetPendingDuplicateFlags flag gambar duplikat yang belum diputuskan admin, yang terbaru di awal
+func (s *ProductRepository) GetPendingDuplicateFlags(offset int, limit int) ([]models.ImageDuplicateFlag, int, error) {
+	flags := []models.ImageDuplicateFlag{}
+	count, err := s.flagQs.ResolvedATIsNull().Count()
+	if err != nil {
+		return flags, 0, err
+	}
+
+	err = s.flagQs.ResolvedATIsNull().
+		OrderDescByID().
+		Offset(offset).
+		Limit(limit).
+		All(&flags)
+
+	return flags, count, err
+}
+
+// GetDuplicateFlag get flag gambar duplikat by id
+func (s *ProductRepository) GetDuplicateFlag(flagID int64) (models.ImageDuplicateFlag, error) {
+	flag := models.ImageDuplicateFlag{}
+	err := s.flagQs.IDEq(flagID).One(&flag)
+
+	return flag, err
+}
+
+// DismissDuplicateFlag tandai flag sebagai bukan duplikat, flag tetap disimpan
+// agar pasangan gambar yang sama tidak diflag ulang
+func (s *ProductRepository) DismissDuplicateFlag(flagID int64, adminID int64) error {
+	now := time.Now().UTC()
+	return s.flagQs.IDEq(flagID).ResolvedATIsNull().GetUpdater().
+		SetResolvedBy(&adminID).
+		SetResolvedAT(&now).
+		Update()
+}
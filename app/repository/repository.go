@@ -0,0 +1,11 @@
+package repository
+
+import "github.com/jinzhu/gorm"
+
+// lastID id terbesar pada tabel, digunakan sebagai cursor awal stream
+func lastID(db *gorm.DB, table string) (int64, error) {
+	row := struct{ ID int64 }{}
+	err := db.Raw("SELECT COALESCE(MAX(id), 0) AS id FROM " + table).Scan(&row).Error
+
+	return row.ID, err
+}
@@ -41,7 +41,7 @@ func (s *StoreRepository) CreateStore(ownerID int64, name string, info string, p
 		return models.Store{}, err
 	}
 
-	s.userQs.IDEq(ownerID).GetUpdater().SetType(2).Update()
+	s.userQs.IDEq(ownerID).GetUpdater().SetType(models.UserTypeAuctioneer).Update()
 
 	return store, nil
 }
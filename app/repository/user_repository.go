@@ -20,6 +20,7 @@ type (
 		connQs     models.UserConnectQuerySet
 		registerQs models.RegisterUserQuerySet
 		passhashQs models.UserPasshashQuerySet
+		blockQs    models.UserBlockQuerySet
 	}
 
 	// UpdateUserQuery definisi query untuk update user
@@ -39,6 +40,7 @@ func NewUserRepository() *UserRepository {
 		connQs:     models.NewUserConnectQuerySet(app.DB),
 		registerQs: models.NewRegisterUserQuerySet(app.DB),
 		passhashQs: models.NewUserPasshashQuerySet(app.DB),
+		blockQs:    models.NewUserBlockQuerySet(app.DB),
 	}
 }
 
@@ -123,7 +125,7 @@ func (s *UserRepository) ActivateUser(token string, passhash string) (*models.Us
 		Email:        registerModel.Email,
 		PhoneNum:     registerModel.PhoneNum,
 		Active:       true,
-		Type:         1,
+		Type:         models.UserTypeBidder,
 		RegisteredAt: time.Now().UTC(),
 	}
 	resUser, err := user.CreateUser()
@@ -172,37 +174,118 @@ func (s *UserRepository) UpdateUser(userID int64, query UpdateUserQuery) (*model
 	return &user, nil
 }
 
-// CreateUserConnect app id untuk spesifik user, digunakan untuk event push notif.
-func (s *UserRepository) CreateUserConnect(userID int64, appID string, providerName string) error {
-	conn := models.UserConnect{
-		UserID:       userID,
-		AppID:        appID,
-		ProviderName: providerName,
-	}
+// SetLocale simpan locale user, digunakan untuk merender notif
+func (s *UserRepository) SetLocale(userID int64, locale string) error {
+	return s.userQs.IDEq(userID).GetUpdater().SetLocale(locale).Update()
+}
 
-	err := s.connQs.UserIDEq(userID).GetUpdater().SetAppID(appID).SetProviderName(providerName).Update()
+// SetLastSeen simpan waktu terakhir user terhubung ke socket
+func (s *UserRepository) SetLastSeen(userID int64, lastSeen time.Time) error {
+	return s.userQs.IDEq(userID).GetUpdater().SetLastSeenAT(&lastSeen).Update()
+}
 
-	if err != nil {
-		if gorm.IsRecordNotFoundError(err) {
-			return conn.Create(app.DB)
+// CreateUserConnect daftarkan device token (app id) user, digunakan untuk event push notif.
+// Token yang sudah terdaftar dipindahkan ke user ini dan last seen-nya diperbarui.
+func (s *UserRepository) CreateUserConnect(userID int64, appID string, providerName string, appVersion string) error {
+	return app.DB.Exec(`
+		INSERT INTO user_connects (user_id, provider_name, app_id, app_version, last_seen_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (app_id) DO UPDATE
+		SET user_id = EXCLUDED.user_id, provider_name = EXCLUDED.provider_name,
+			app_version = EXCLUDED.app_version, last_seen_at = EXCLUDED.last_seen_at`,
+		userID, providerName, appID, appVersion, time.Now().UTC(),
+	).Error
+}
+
+// GetUserConnects semua device milik user, yang terakhir aktif di awal
+func (s *UserRepository) GetUserConnects(userID int64) ([]models.UserConnect, error) {
+	conns := []models.UserConnect{}
+	err := s.connQs.UserIDEq(userID).OrderDescByLastSeenAT().All(&conns)
+
+	return conns, err
+}
+
+// RemoveUserConnect hapus satu device user berdasarkan app id
+func (s *UserRepository) RemoveUserConnect(userID int64, appID string) error {
+	return s.connQs.UserIDEq(userID).AppIDEq(appID).Delete()
+}
+
+// RemoveUserConnects hapus semua device milik user
+func (s *UserRepository) RemoveUserConnects(userID int64) error {
+	return s.connQs.UserIDEq(userID).Delete()
+}
+
+// PruneAppIDs hapus app id yang dilaporkan tidak valid oleh provider push notif
+func (s *UserRepository) PruneAppIDs(appIDs ...string) error {
+	if len(appIDs) == 0 {
+		return nil
+	}
+	return s.connQs.AppIDIn(appIDs...).Delete()
+}
+
+// ReplaceAppID ganti app id lama dengan canonical app id dari provider push notif,
+// jika canonical app id sudah terdaftar maka app id lama cukup dihapus
+func (s *UserRepository) ReplaceAppID(oldAppID string, newAppID string) error {
+	return s.connQs.GetDB().Transaction(func(tx *gorm.DB) error {
+		count, err := models.NewUserConnectQuerySet(tx).AppIDEq(newAppID).Count()
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return models.NewUserConnectQuerySet(tx).AppIDEq(oldAppID).Delete()
 		}
-		return err
+		return models.NewUserConnectQuerySet(tx).AppIDEq(oldAppID).GetUpdater().SetAppID(newAppID).Update()
+	})
+}
+
+// BlockUser tambahkan user ke block list blocker, block yang sudah ada tidak diubah
+func (s *UserRepository) BlockUser(blockerID int64, blockedID int64) error {
+	if blockerID == blockedID {
+		return errors.New("Tidak dapat memblokir diri sendiri")
 	}
 
-	return nil
+	return app.DB.Exec(`
+		INSERT INTO user_blocks (blocker_id, blocked_id, created_at) VALUES (?, ?, ?)
+		ON CONFLICT (blocker_id, blocked_id) DO NOTHING`,
+		blockerID, blockedID, time.Now().UTC(),
+	).Error
+}
+
+// UnblockUser hapus user dari block list blocker
+func (s *UserRepository) UnblockUser(blockerID int64, blockedID int64) error {
+	return s.blockQs.BlockerIDEq(blockerID).BlockedIDEq(blockedID).Delete()
 }
 
-// GetAppID berdasarkan user id
-func (s *UserRepository) GetAppID(userID int64) (models.UserConnect, error) {
-	conn := models.UserConnect{}
-	if err := s.connQs.UserIDEq(userID).One(&conn); err != nil {
-		return conn, err
+// GetBlockedUsers block list milik blocker, yang terakhir diblokir di awal
+func (s *UserRepository) GetBlockedUsers(blockerID int64, offset int, limit int) ([]models.UserBlock, int, error) {
+	blocks := []models.UserBlock{}
+	count, err := s.blockQs.BlockerIDEq(blockerID).Count()
+	if err != nil {
+		return blocks, 0, err
 	}
 
-	return conn, nil
+	err = s.blockQs.BlockerIDEq(blockerID).
+		OrderDescByCreatedAT().
+		Offset(offset).
+		Limit(limit).
+		All(&blocks)
+
+	return blocks, count, err
 }
 
-// RemoveUserConnect app id berdasarkan user id
-func (s *UserRepository) RemoveUserConnect(userID int64) error {
-	return s.connQs.UserIDEq(userID).Delete()
+// HasBlocked cek apakah blocker memblokir user
+func (s *UserRepository) HasBlocked(blockerID int64, userID int64) bool {
+	count, err := s.blockQs.BlockerIDEq(blockerID).BlockedIDEq(userID).Count()
+	return err == nil && count > 0
+}
+
+// IsBlockedBetween cek apakah salah satu dari dua user memblokir yang lain
+func (s *UserRepository) IsBlockedBetween(userID int64, otherID int64) bool {
+	row := struct{ Count int }{}
+	err := app.DB.Raw(`
+		SELECT COUNT(*) AS count FROM user_blocks
+		WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)`,
+		userID, otherID, otherID, userID,
+	).Scan(&row).Error
+
+	return err == nil && row.Count > 0
 }
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+)
+
+type (
+	// WebhookRepository init implementation
+	WebhookRepository struct {
+		webhookQs  models.StoreWebhookQuerySet
+		deliveryQs models.WebhookDeliveryQuerySet
+		attemptQs  models.WebhookDeliveryAttemptQuerySet
+	}
+
+	// NewWebhookQuery query untuk menambahkan webhook
+	NewWebhookQuery struct {
+		URL        string   `json:"url" binding:"required"`
+		EventTypes []string `json:"event_types" binding:"required"`
+	}
+)
+
+// NewWebhookRepository instance
+func NewWebhookRepository() *WebhookRepository {
+	return &WebhookRepository{
+		webhookQs:  models.NewStoreWebhookQuerySet(app.DB),
+		deliveryQs: models.NewWebhookDeliveryQuerySet(app.DB),
+		attemptQs:  models.NewWebhookDeliveryAttemptQuerySet(app.DB),
+	}
+}
+
+// CreateWebhook menambahkan webhook untuk store
+func (r *WebhookRepository) CreateWebhook(storeID int64, secret string, query NewWebhookQuery) (models.StoreWebhook, error) {
+	now := time.Now().UTC()
+	webhook := models.StoreWebhook{
+		StoreID:    storeID,
+		URL:        query.URL,
+		Secret:     secret,
+		EventTypes: strings.Join(query.EventTypes, ","),
+		Active:     true,
+		CreatedAT:  &now,
+	}
+
+	if err := webhook.Create(app.DB); err != nil {
+		return models.StoreWebhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// GetByID get webhook by id
+func (r *WebhookRepository) GetByID(webhookID int64) (models.StoreWebhook, error) {
+	webhook := models.StoreWebhook{}
+	err := r.webhookQs.IDEq(webhookID).One(&webhook)
+
+	return webhook, err
+}
+
+// GetStoreWebhooks list webhook milik store
+func (r *WebhookRepository) GetStoreWebhooks(storeID int64) ([]models.StoreWebhook, error) {
+	webhooks := []models.StoreWebhook{}
+	err := r.webhookQs.StoreIDEq(storeID).OrderAscByID().All(&webhooks)
+
+	return webhooks, err
+}
+
+// DeleteWebhook menghapus webhook beserta log delivery-nya
+func (r *WebhookRepository) DeleteWebhook(webhookID int64) error {
+	return r.webhookQs.IDEq(webhookID).Delete()
+}
+
+// EnableWebhook mengaktifkan lagi webhook yang dinonaktifkan otomatis
+func (r *WebhookRepository) EnableWebhook(webhookID int64) error {
+	return r.webhookQs.IDEq(webhookID).GetUpdater().
+		SetActive(true).
+		SetFailureCount(0).
+		SetDisabledAT(nil).
+		Update()
+}
+
+// GetDeliveries log delivery untuk webhook, terbaru lebih dulu
+func (r *WebhookRepository) GetDeliveries(webhookID int64, offset int, limit int) ([]models.WebhookDelivery, int, error) {
+	deliveries := []models.WebhookDelivery{}
+	err := r.deliveryQs.WebhookIDEq(webhookID).OrderDescByID().Offset(offset).Limit(limit).All(&deliveries)
+	if err != nil {
+		return deliveries, 0, err
+	}
+
+	count, err := r.deliveryQs.WebhookIDEq(webhookID).Count()
+
+	return deliveries, count, err
+}
+
+// GetDeliveryByID get delivery by id
+func (r *WebhookRepository) GetDeliveryByID(deliveryID int64) (models.WebhookDelivery, error) {
+	delivery := models.WebhookDelivery{}
+	err := r.deliveryQs.IDEq(deliveryID).One(&delivery)
+
+	return delivery, err
+}
+
+// GetDeliveryAttempts semua percobaan pengiriman untuk delivery
+func (r *WebhookRepository) GetDeliveryAttempts(deliveryID int64) ([]models.WebhookDeliveryAttempt, error) {
+	attempts := []models.WebhookDeliveryAttempt{}
+	err := r.attemptQs.DeliveryIDEq(deliveryID).OrderAscByID().All(&attempts)
+
+	return attempts, err
+}
@@ -20,6 +20,29 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 	{
 		// @StartCodeBlocks
 
+		// Generate route for AttachmentService
+		attachmentService := service.NewAttachmentService()
+		attachmentServiceGroup := apiGroup.Group("/attachment/v1")
+		{
+			attachmentServiceGroup.POST("/upload", mid.RequiresUserAuth, func(c *gin.Context) {
+				attachmentService.UploadAttachment(c)
+				})
+			attachmentServiceGroup.GET("/url", mid.RequiresUserAuth, func(c *gin.Context) {
+				query, err := mid.ReqValidate(c, &service.QueryAttachment{}, binding.Query)
+				if err != nil {
+					return
+				}
+				attachmentService.AttachmentURL(c, query.(*service.QueryAttachment))
+			})
+			attachmentServiceGroup.GET("/download", func(c *gin.Context) {
+				query, err := mid.ReqValidate(c, &service.QueryDownload{}, binding.Query)
+				if err != nil {
+					return
+				}
+				attachmentService.DownloadAttachment(c, query.(*service.QueryDownload))
+			})
+		}
+
 		// Generate route for AuthService
 		authService := service.NewAuthService()
 		authServiceGroup := apiGroup.Group("/auth/v1")
@@ -62,6 +85,15 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 				}
 				chatService.ListChatRooms(c, query.(*service.QueryEntries))
 			})
+			chatServiceGroup.GET("/product-rooms", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QueryProductChats{}, binding.Query)
+				if err != nil {
+					return
+				}
+				chatService.ListProductChatRooms(c, query.(*service.QueryProductChats))
+			})
 			chatServiceGroup.POST("/send-message", mid.RequiresUserAuth, func(c *gin.Context) {
 				chatService.Lock()
 				defer chatService.Unlock()
@@ -80,6 +112,91 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 				}
 				chatService.ListChatMessages(c, query.(*service.QueryMessages))
 			})
+			chatServiceGroup.POST("/edit-message", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.EditMessageQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				chatService.EditMessage(c, query.(*service.EditMessageQuery))
+			})
+			chatServiceGroup.POST("/delete-message", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.DeleteMessageQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				chatService.DeleteMessage(c, query.(*service.DeleteMessageQuery))
+			})
+			chatServiceGroup.POST("/read", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.ReadChatQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				chatService.ReadChat(c, query.(*service.ReadChatQuery))
+			})
+			chatServiceGroup.GET("/presence", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QueryPresence{}, binding.Query)
+				if err != nil {
+					return
+				}
+				chatService.GetPresence(c, query.(*service.QueryPresence))
+			})
+			chatServiceGroup.GET("/search-messages", mid.RequiresUserAuth, func(c *gin.Context) {
+				chatService.Lock()
+				defer chatService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QuerySearchMessages{}, binding.Query)
+				if err != nil {
+					return
+				}
+				chatService.SearchMessages(c, query.(*service.QuerySearchMessages))
+			})
+		}
+
+		// Generate route for ModerationService
+		moderationService := service.NewModerationService()
+		moderationServiceGroup := apiGroup.Group("/moderation/v1")
+		{
+			moderationServiceGroup.GET("/duplicate-flags", mid.RequiresUserAuth, func(c *gin.Context) {
+				moderationService.Lock()
+				defer moderationService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QueryEntries{}, binding.Query)
+				if err != nil {
+					return
+				}
+				moderationService.ListDuplicateFlags(c, query.(*service.QueryEntries))
+			})
+			moderationServiceGroup.POST("/resolve-duplicate", mid.RequiresUserAuth, func(c *gin.Context) {
+				moderationService.Lock()
+				defer moderationService.Unlock()
+				query, err := mid.ReqValidate(c, &service.ResolveDuplicateQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				moderationService.ResolveDuplicateFlag(c, query.(*service.ResolveDuplicateQuery))
+			})
+		}
+
+		// Generate route for ProductImageService
+		productImageService := service.NewProductImageService()
+		productImageServiceGroup := apiGroup.Group("/product-image/v1")
+		{
+			productImageServiceGroup.POST("/upload", mid.RequiresUserAuth, func(c *gin.Context) {
+				productImageService.UploadProductImage(c)
+				})
+			productImageServiceGroup.GET("/file", func(c *gin.Context) {
+				query, err := mid.ReqValidate(c, &service.QueryImageFile{}, binding.Query)
+				if err != nil {
+					return
+				}
+				productImageService.ProductImageFile(c, query.(*service.QueryImageFile))
+			})
 		}
 
 		// Generate route for ProductService
@@ -178,6 +295,19 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 			})
 		}
 
+		// Generate route for StreamService
+		streamService := service.NewStreamService()
+		streamServiceGroup := apiGroup.Group("/stream/v1")
+		{
+			streamServiceGroup.GET("/events", func(c *gin.Context) {
+				query, err := mid.ReqValidate(c, &service.QueryStream{}, binding.Query)
+				if err != nil {
+					return
+				}
+				streamService.StreamEvents(c, query.(*service.QueryStream))
+			})
+		}
+
 		// Generate route for UserService
 		userService := service.NewUserService()
 		userServiceGroup := apiGroup.Group("/user/v1")
@@ -214,6 +344,15 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 				}
 				userService.UpdateUserInfo(c, query.(*repo.UpdateUserQuery))
 			})
+			userServiceGroup.POST("/me/locale", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.LocaleQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.SetUserLocale(c, query.(*service.LocaleQuery))
+			})
 			userServiceGroup.GET("/me/store", mid.RequiresUserAuth, func(c *gin.Context) {
 				userService.Lock()
 				defer userService.Unlock()
@@ -249,17 +388,26 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 			userServiceGroup.POST("/connect-remove", mid.RequiresUserAuth, func(c *gin.Context) {
 				userService.Lock()
 				defer userService.Unlock()
-				userService.ConnectRemove(c)
-				})
+				query, err := mid.ReqValidate(c, &service.ConnectRemoveQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.ConnectRemove(c, query.(*service.ConnectRemoveQuery))
+			})
 			userServiceGroup.GET("/notifs", mid.RequiresUserAuth, func(c *gin.Context) {
 				userService.Lock()
 				defer userService.Unlock()
-				query, err := mid.ReqValidate(c, &service.QueryEntries{}, binding.Query)
+				query, err := mid.ReqValidate(c, &service.QueryNotifs{}, binding.Query)
 				if err != nil {
 					return
 				}
-				userService.ListUserNotifs(c, query.(*service.QueryEntries))
+				userService.ListUserNotifs(c, query.(*service.QueryNotifs))
 			})
+			userServiceGroup.GET("/notifs/unread-count", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				userService.UnreadNotifCount(c)
+				})
 			userServiceGroup.POST("/notifs/read", mid.RequiresUserAuth, func(c *gin.Context) {
 				userService.Lock()
 				defer userService.Unlock()
@@ -269,6 +417,144 @@ func GetGeneratedRoutes(router *gin.Engine) *gin.Engine {
 				}
 				userService.MarkAsReadNotif(c, query.(*service.ReadNotifQuery))
 			})
+			userServiceGroup.POST("/notifs/read-all", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				userService.MarkAllAsReadNotif(c)
+				})
+			userServiceGroup.POST("/notifs/delete", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.ReadNotifQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.DeleteNotifs(c, query.(*service.ReadNotifQuery))
+			})
+			userServiceGroup.GET("/notifs/settings", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				userService.GetNotifSettings(c)
+				})
+			userServiceGroup.POST("/notifs/preference", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.NotifPreferenceQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.SetNotifPreference(c, query.(*service.NotifPreferenceQuery))
+			})
+			userServiceGroup.POST("/notifs/quiet-hours", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QuietHoursQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.SetQuietHours(c, query.(*service.QuietHoursQuery))
+			})
+			userServiceGroup.POST("/notifs/digest", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.DailyDigestQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.SetDailyDigest(c, query.(*service.DailyDigestQuery))
+			})
+			userServiceGroup.POST("/block", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.BlockUserQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.BlockUser(c, query.(*service.BlockUserQuery))
+			})
+			userServiceGroup.POST("/unblock", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.BlockUserQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				userService.UnblockUser(c, query.(*service.BlockUserQuery))
+			})
+			userServiceGroup.GET("/blocks", mid.RequiresUserAuth, func(c *gin.Context) {
+				userService.Lock()
+				defer userService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QueryEntries{}, binding.Query)
+				if err != nil {
+					return
+				}
+				userService.ListBlockedUsers(c, query.(*service.QueryEntries))
+			})
+		}
+
+		// Generate route for WebhookService
+		webhookService := service.NewWebhookService()
+		webhookServiceGroup := apiGroup.Group("/webhook/v1")
+		{
+			webhookServiceGroup.POST("/add", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &repo.NewWebhookQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				webhookService.AddWebhook(c, query.(*repo.NewWebhookQuery))
+			})
+			webhookServiceGroup.GET("/list", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				webhookService.ListWebhooks(c)
+				})
+			webhookServiceGroup.POST("/delete", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &service.IDQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				webhookService.DeleteWebhook(c, query.(*service.IDQuery))
+			})
+			webhookServiceGroup.POST("/enable", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &service.IDQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				webhookService.EnableWebhook(c, query.(*service.IDQuery))
+			})
+			webhookServiceGroup.GET("/deliveries", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &service.QueryDeliveries{}, binding.Query)
+				if err != nil {
+					return
+				}
+				webhookService.ListDeliveries(c, query.(*service.QueryDeliveries))
+			})
+			webhookServiceGroup.GET("/deliveries/attempts", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &service.IDQuery{}, binding.Query)
+				if err != nil {
+					return
+				}
+				webhookService.ListDeliveryAttempts(c, query.(*service.IDQuery))
+			})
+			webhookServiceGroup.POST("/redeliver", mid.RequiresUserAuth, func(c *gin.Context) {
+				webhookService.Lock()
+				defer webhookService.Unlock()
+				query, err := mid.ReqValidate(c, &service.IDQuery{}, binding.JSON)
+				if err != nil {
+					return
+				}
+				webhookService.Redeliver(c, query.(*service.IDQuery))
+			})
 		}
 
 		// @EndCodeBlocks
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// AttachmentService api implementation untuk upload dan download attachment chat
+	AttachmentService struct{}
+
+	// QueryAttachment request type struct
+	QueryAttachment struct {
+		AttachmentID int64 `form:"attachment_id" binding:"required"`
+	}
+
+	// QueryDownload request type struct, berasal dari url hasil AttachmentURL
+	QueryDownload struct {
+		ID      int64  `form:"id" binding:"required"`
+		Expires int64  `form:"expires" binding:"required"`
+		Sig     string `form:"sig" binding:"required"`
+	}
+)
+
+// NewAttachmentService instance
+// @RouterGroup /attachment/v1
+func NewAttachmentService() *AttachmentService {
+	return &AttachmentService{}
+}
+
+// UploadAttachment docs
+// @Tags AttachmentService
+// @Security bearerAuth
+// @Summary Endpoint untuk upload attachment chat (gambar atau pdf), kirim dengan attachment_id pada send-message
+// @Accept multipart/form-data
+// @Produce json
+// @Param chat_id formData int true "ChatID"
+// @Param file formData file true "File"
+// @Success 200 {object} app.Result{result=chat.AttachmentLink}
+// @Failure 400 {object} app.Result
+// @Router /upload [post] [auth] [nolock]
+func (s *AttachmentService) UploadAttachment(c *gin.Context) {
+	// sisakan ruang untuk field lain dan boundary multipart
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, chat.MaxAttachmentSize+(1<<20))
+
+	chatID, err := strconv.ParseInt(c.PostForm("chat_id"), 10, 64)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "chat_id tidak valid")
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, chat.ErrAttachmentTooLarge.Error())
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "File tidak dapat dibaca")
+		return
+	}
+	defer file.Close()
+
+	attachment, err := chat.Upload(mid.CurrentUser.ID, chatID, header.Filename, file, header.Size)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	link, err := chat.ResolveAttachment(mid.CurrentUser.ID, attachment.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, link)
+}
+
+// AttachmentURL docs
+// @Tags AttachmentService
+// @Security bearerAuth
+// @Summary Endpoint untuk mendapatkan url download attachment, hanya untuk participant chat
+// @Accept json
+// @Produce json
+// @Param attachment_id query int true "AttachmentID"
+// @Success 200 {object} app.Result{result=chat.AttachmentLink}
+// @Failure 400 {object} app.Result
+// @Router /url [get] [auth] [nolock]
+func (s *AttachmentService) AttachmentURL(c *gin.Context, query *QueryAttachment) {
+	link, err := chat.ResolveAttachment(mid.CurrentUser.ID, query.AttachmentID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, link)
+}
+
+// DownloadAttachment docs
+// @Tags AttachmentService
+// @Summary Endpoint untuk download attachment dengan url yang sudah ditandatangani
+// @Produce octet-stream
+// @Param id query int true "ID"
+// @Param expires query int true "Expires"
+// @Param sig query string true "Signature"
+// @Success 200 {file} file
+// @Failure 400 {object} app.Result
+// @Router /download [get] [nolock]
+func (s *AttachmentService) DownloadAttachment(c *gin.Context, query *QueryDownload) {
+	attachment, file, err := chat.OpenAttachment(query.ID, query.Expires, query.Sig)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer file.Close()
+
+	disposition := "inline"
+	if attachment.Kind != chat.AttachmentImage {
+		disposition = "attachment"
+	}
+	c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, file, map[string]string{
+		"Content-Disposition":    fmt.Sprintf("%s; filename=%q", disposition, attachment.FileName),
+		"Cache-Control":          "private, max-age=3600",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
@@ -2,51 +2,198 @@ package service
 
 import (
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	mid "github.com/fatkhur1960/goauction/app/middleware"
 	"github.com/fatkhur1960/goauction/app/models"
 	repo "github.com/fatkhur1960/goauction/app/repository"
 	"github.com/fatkhur1960/goauction/app/types"
+	"github.com/fatkhur1960/goauction/system/chat"
 	"github.com/gin-gonic/gin"
 )
 
+// maxSearchLimit batas jumlah message hasil pencarian dalam satu halaman
+const maxSearchLimit = 50
+
 type (
 	// ChatService api implementation
 	ChatService struct {
 		sync.Mutex
-		chatRepo *repo.ChatRepository
+		chatRepo    *repo.ChatRepository
+		userRepo    *repo.UserRepository
+		productRepo *repo.ProductRepository
+		storeRepo   *repo.StoreRepository
 	}
 
-	// CreateChatQuery --
+	// CreateChatQuery definisi query membuat chat room, isi product_id untuk bertanya
+	// tentang product kepada penjualnya, user_id boleh kosong jika product_id diisi
 	CreateChatQuery struct {
-		UserID int64 `json:"user_id" binding:"required"`
+		UserID    int64 `json:"user_id"`
+		ProductID int64 `json:"product_id"`
+	}
+
+	// EditMessageQuery definisi query edit message
+	EditMessageQuery struct {
+		MessageID int64  `json:"message_id" binding:"required"`
+		Text      string `json:"text" binding:"required"`
+	}
+
+	// DeleteMessageQuery definisi query hapus message, for_everyone menghapus isi message
+	// untuk semua participant, selain itu hanya dari history user
+	DeleteMessageQuery struct {
+		MessageID   int64 `json:"message_id" binding:"required"`
+		ForEveryone bool  `json:"for_everyone"`
+	}
+
+	// QueryProductChats request type struct
+	QueryProductChats struct {
+		ProductID int64 `form:"product_id" binding:"required"`
+		Limit     int   `form:"limit" binding:"required"`
+		Offset    int   `form:"offset"`
+	}
+
+	// ReadChatQuery definisi query read receipt, message_id kosong berarti sampai message terakhir
+	ReadChatQuery struct {
+		ChatID    int64 `json:"chat_id" binding:"required"`
+		MessageID int64 `json:"message_id"`
+	}
+
+	// QueryPresence definisi query presence lawan chat
+	QueryPresence struct {
+		ChatID int64 `form:"chat_id" binding:"required"`
+	}
+
+	// QuerySearchMessages definisi query pencarian message, before adalah next_cursor
+	// dari halaman sebelumnya. since dan until berformat YYYY-MM-DD atau RFC3339,
+	// kind salah satu dari image, document atau attachment
+	QuerySearchMessages struct {
+		Query    string `form:"query"`
+		ChatID   int64  `form:"chat_id"`
+		SenderID int64  `form:"sender_id"`
+		Since    string `form:"since"`
+		Until    string `form:"until"`
+		Kind     string `form:"kind"`
+		Before   int64  `form:"before"`
+		Limit    int    `form:"limit" binding:"required"`
+	}
+
+	// SearchMessagesResult result pencarian message dengan cursor untuk halaman berikutnya,
+	// next_cursor 0 berarti tidak ada halaman berikutnya
+	SearchMessagesResult struct {
+		Entries    []repo.MessageHit `json:"entries"`
+		NextCursor int64             `json:"next_cursor"`
 	}
 )
 
 // NewChatService instance
 // @RouterGroup /chat/v1
 func NewChatService() *ChatService {
-	return &ChatService{chatRepo: repo.NewChatRepository()}
+	return &ChatService{
+		chatRepo:    repo.NewChatRepository(),
+		userRepo:    repo.NewUserRepository(),
+		productRepo: repo.NewProductRepository(),
+		storeRepo:   repo.NewStoreRepository(),
+	}
+}
+
+// productOwner pemilik store dari product
+func (s *ChatService) productOwner(productID int64) (int64, error) {
+	product, err := s.productRepo.GetByID(productID)
+	if err != nil {
+		return 0, err
+	}
+
+	store, err := s.storeRepo.GetByID(product.StoreID)
+	if err != nil {
+		return 0, err
+	}
+
+	return store.OwnerID, nil
+}
+
+// toChatEntries lengkapi chat room dengan display, product dan status baca untuk current user
+func (s *ChatService) toChatEntries(chats []models.Chat) ([]types.Chat, error) {
+	entries := []types.Chat{}
+	chatIDs := make([]int64, 0, len(chats))
+	for _, room := range chats {
+		chatIDs = append(chatIDs, room.ID)
+	}
+	states, err := s.chatRepo.GetChatReadStates(mid.CurrentUser.ID, chatIDs)
+	if err != nil {
+		return entries, err
+	}
+
+	for _, room := range chats {
+		entry := room.ToAPI(mid.CurrentUser.ID)
+		entry.Unread = states[room.ID].Unread
+		entry.PartnerReadID = states[room.ID].PartnerReadID
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
 }
 
 // CreateChatRoom docs
-// @Summary Endpoint untuk membuat chat room
+// @Summary Endpoint untuk membuat chat room, room yang sudah ada dengan user yang sama akan digunakan kembali
 // @Tags ChatService
 // @Accept json
 // @Produce json
-// @Param user_id body int true "UserID"
-// @Success 200 {object} app.Result{result=models.Chat}
+// @Param user_id body int false "UserID"
+// @Param product_id body int false "ProductID"
+// @Success 200 {object} app.Result{result=types.Chat}
 // @Failure 400 {object} app.Result
+// @Failure 403 {object} app.Result
 // @Router /new-room [post] [auth]
 func (s *ChatService) CreateChatRoom(c *gin.Context, query *CreateChatQuery) {
-	chat, err := s.chatRepo.CreateChat(mid.CurrentUser.ID, query.UserID)
+	subscriberID := query.UserID
+	if query.ProductID != 0 {
+		ownerID, err := s.productOwner(query.ProductID)
+		if err != nil {
+			APIResult.Error(c, http.StatusBadRequest, "Product tidak ditemukan")
+			return
+		}
+		if subscriberID == 0 {
+			subscriberID = ownerID
+		} else if subscriberID != ownerID {
+			APIResult.Error(c, http.StatusBadRequest, "Product bukan milik user ini")
+			return
+		}
+	}
+
+	if subscriberID == 0 {
+		APIResult.Error(c, http.StatusBadRequest, "user_id atau product_id harus diisi")
+		return
+	}
+	if subscriberID == mid.CurrentUser.ID {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuat chat dengan diri sendiri")
+		return
+	}
+	if _, err := s.userRepo.GetByID(subscriberID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "User tidak ditemukan")
+		return
+	}
+	if s.userRepo.IsBlockedBetween(mid.CurrentUser.ID, subscriberID) {
+		APIResult.Error(c, http.StatusForbidden, chat.ErrBlocked.Error())
+		return
+	}
+
+	room, err := s.chatRepo.CreateChat(mid.CurrentUser.ID, subscriberID)
 	if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuat chat")
 		return
 	}
 
-	APIResult.Success(c, chat.ToAPI(mid.CurrentUser.ID))
+	if query.ProductID != 0 {
+		if err := s.chatRepo.LinkChatProduct(room.ID, query.ProductID); err != nil {
+			APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuat chat")
+			return
+		}
+		room.ProductID = &query.ProductID
+	}
+
+	APIResult.Success(c, room.ToAPI(mid.CurrentUser.ID))
 }
 
 // ListChatRooms docs
@@ -63,11 +210,50 @@ func (s *ChatService) CreateChatRoom(c *gin.Context, query *CreateChatQuery) {
 // @Failure 400 {object} app.Result
 // @Router /list [get] [auth]
 func (s *ChatService) ListChatRooms(c *gin.Context, query *QueryEntries) {
-	entries := []types.Chat{}
 	chats, count, _ := s.chatRepo.GetUserChatRooms(mid.CurrentUser.ID, query.Offset, query.Limit)
+	entries, err := s.toChatEntries(chats)
+	if err != nil {
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat memuat chat")
+		return
+	}
+
+	APIResult.Success(c, EntriesResult{entries, count})
+}
 
-	for _, chat := range chats {
-		entries = append(entries, chat.ToAPI(mid.CurrentUser.ID))
+// ListProductChatRooms docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan list chat room yang membicarakan product, hanya untuk pemilik product
+// @Accept json
+// @Produce json
+// @Param product_id query int true "ProductID"
+// @Param limit query int true "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} app.Result{result=EntriesResult{entries=[]types.Chat}}
+// @Failure 400 {object} app.Result
+// @Failure 401 {object} app.Result
+// @Router /product-rooms [get] [auth]
+func (s *ChatService) ListProductChatRooms(c *gin.Context, query *QueryProductChats) {
+	ownerID, err := s.productOwner(query.ProductID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Product tidak ditemukan")
+		return
+	}
+	if ownerID != mid.CurrentUser.ID {
+		APIResult.Error(c, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	chats, count, err := s.chatRepo.GetProductChatRooms(query.ProductID, query.Offset, query.Limit)
+	if err != nil {
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat memuat chat")
+		return
+	}
+
+	entries, err := s.toChatEntries(chats)
+	if err != nil {
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat memuat chat")
+		return
 	}
 
 	APIResult.Success(c, EntriesResult{entries, count})
@@ -79,22 +265,24 @@ func (s *ChatService) ListChatRooms(c *gin.Context, query *QueryEntries) {
 // @Summary Endpoint untuk menambahkan product
 // @Accept json
 // @Param chat_id body int true "ChatID"
-// @Param receiver_id body int true "ReceiverID"
-// @Param text body string true "Text"
-// @Param attachment_kind body int true "AttachmentKind"
-// @Param attachment_data body string true "AttachmentData"
+// @Param text body string false "Text"
+// @Param attachment_id body int false "AttachmentID"
 // @Produce json
-// @Success 200 {object} app.Result{result=models.Message}
+// @Success 200 {object} app.Result{result=chat.Reply}
 // @Failure 400 {object} app.Result
+// @Failure 403 {object} app.Result
 // @Router /send-message [post] [auth]
 func (s *ChatService) SendMessage(c *gin.Context, query *repo.ChatMessageQuery) {
-	message, err := s.chatRepo.CreateChatMessage(mid.CurrentUser.ID, *query)
-	if err != nil {
+	reply, err := chat.Send(mid.CurrentUser.ID, *query)
+	if err == chat.ErrBlocked {
+		APIResult.Error(c, http.StatusForbidden, err.Error())
+		return
+	} else if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	APIResult.Success(c, message)
+	APIResult.Success(c, reply)
 }
 
 // ListChatMessages docs
@@ -106,13 +294,25 @@ func (s *ChatService) SendMessage(c *gin.Context, query *repo.ChatMessageQuery)
 // @Param limit query int true "Limit"
 // @Param offset query int true "Offset"
 // @Param query query string false "Query"
-// @Param filter query string false "Filter"
+// @Param filter query string false "Filter: image, document atau attachment"
 // @Success 200 {object} app.Result{result=EntriesResult{entries=[]models.Message}}
 // @Failure 400 {object} app.Result
 // @Router /list-messages [get] [auth]
 func (s *ChatService) ListChatMessages(c *gin.Context, query *QueryMessages) {
+	if !s.chatRepo.IsChatParticipant(query.ChatID, mid.CurrentUser.ID) {
+		APIResult.Error(c, http.StatusUnauthorized, chat.ErrNotParticipant.Error())
+		return
+	}
+
+	kinds, ok := attachmentKinds(query.Filter)
+	if !ok {
+		APIResult.Error(c, http.StatusBadRequest, "Filter harus image, document atau attachment")
+		return
+	}
+
 	entries := []models.Message{}
-	messages, count, _ := s.chatRepo.GetChatMessages(query.ChatID, mid.CurrentUser.ID, query.Offset, query.Limit)
+	search := repo.MessageSearch{Query: query.Query, AttachmentKinds: kinds}
+	messages, count, _ := s.chatRepo.FilterChatMessages(query.ChatID, mid.CurrentUser.ID, search, query.Offset, query.Limit)
 
 	for _, message := range messages {
 		entries = append(entries, message)
@@ -120,3 +320,175 @@ func (s *ChatService) ListChatMessages(c *gin.Context, query *QueryMessages) {
 
 	APIResult.Success(c, EntriesResult{entries, count})
 }
+
+// EditMessage docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk mengubah text message, hanya pengirim dalam batas waktu edit
+// @Accept json
+// @Produce json
+// @Param message_id body int true "MessageID"
+// @Param text body string true "Text"
+// @Success 200 {object} app.Result{result=chat.Reply}
+// @Failure 400 {object} app.Result
+// @Router /edit-message [post] [auth]
+func (s *ChatService) EditMessage(c *gin.Context, query *EditMessageQuery) {
+	reply, err := chat.Edit(mid.CurrentUser.ID, query.MessageID, query.Text)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, reply)
+}
+
+// DeleteMessage docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk menghapus message dari history user atau untuk semua participant
+// @Accept json
+// @Produce json
+// @Param message_id body int true "MessageID"
+// @Param for_everyone body bool false "ForEveryone"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /delete-message [post] [auth]
+func (s *ChatService) DeleteMessage(c *gin.Context, query *DeleteMessageQuery) {
+	if err := chat.Delete(mid.CurrentUser.ID, query.MessageID, query.ForEveryone); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// ReadChat docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk menandai message pada chat room sudah dibaca
+// @Accept json
+// @Produce json
+// @Param chat_id body int true "ChatID"
+// @Param message_id body int false "MessageID"
+// @Success 200 {object} app.Result{result=models.ChatRead}
+// @Failure 400 {object} app.Result
+// @Router /read [post] [auth]
+func (s *ChatService) ReadChat(c *gin.Context, query *ReadChatQuery) {
+	read, err := chat.MarkRead(mid.CurrentUser.ID, query.ChatID, query.MessageID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, read)
+}
+
+// GetPresence docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan status online lawan chat
+// @Accept json
+// @Produce json
+// @Param chat_id query int true "ChatID"
+// @Success 200 {object} app.Result{result=chat.Presence}
+// @Failure 400 {object} app.Result
+// @Router /presence [get] [auth]
+func (s *ChatService) GetPresence(c *gin.Context, query *QueryPresence) {
+	presence, err := chat.PartnerPresence(mid.CurrentUser.ID, query.ChatID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, presence)
+}
+
+// SearchMessages docs
+// @Tags ChatService
+// @Security bearerAuth
+// @Summary Endpoint untuk mencari message pada semua chat room current user, diurutkan dari yang terbaru
+// @Produce json
+// @Param query query string false "Query"
+// @Param chat_id query int false "ChatID"
+// @Param sender_id query int false "SenderID"
+// @Param since query string false "Since"
+// @Param until query string false "Until"
+// @Param kind query string false "Kind: image, document atau attachment"
+// @Param before query int false "Before"
+// @Param limit query int true "Limit"
+// @Success 200 {object} app.Result{result=SearchMessagesResult}
+// @Failure 400 {object} app.Result
+// @Router /search-messages [get] [auth]
+func (s *ChatService) SearchMessages(c *gin.Context, query *QuerySearchMessages) {
+	if query.Limit > maxSearchLimit {
+		query.Limit = maxSearchLimit
+	}
+
+	search := repo.MessageSearch{
+		Query:    strings.TrimSpace(query.Query),
+		ChatID:   query.ChatID,
+		SenderID: query.SenderID,
+		Before:   query.Before,
+	}
+
+	var ok bool
+	if search.AttachmentKinds, ok = attachmentKinds(query.Kind); !ok {
+		APIResult.Error(c, http.StatusBadRequest, "Kind harus image, document atau attachment")
+		return
+	}
+	if search.Since, ok = parseSearchDate(query.Since, false); !ok {
+		APIResult.Error(c, http.StatusBadRequest, "Format since harus YYYY-MM-DD atau RFC3339")
+		return
+	}
+	if search.Until, ok = parseSearchDate(query.Until, true); !ok {
+		APIResult.Error(c, http.StatusBadRequest, "Format until harus YYYY-MM-DD atau RFC3339")
+		return
+	}
+
+	hits, err := s.chatRepo.SearchMessages(mid.CurrentUser.ID, search, query.Limit)
+	if err != nil {
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat mencari pesan")
+		return
+	}
+
+	result := SearchMessagesResult{Entries: hits}
+	if len(hits) == query.Limit {
+		result.NextCursor = hits[len(hits)-1].ID
+	}
+
+	APIResult.Success(c, result)
+}
+
+// attachmentKinds jenis attachment untuk filter message, filter kosong berarti semua message
+func attachmentKinds(filter string) ([]int, bool) {
+	switch filter {
+	case "":
+		return nil, true
+	case "image":
+		return []int{chat.AttachmentImage}, true
+	case "document":
+		return []int{chat.AttachmentDocument}, true
+	case "attachment":
+		return []int{chat.AttachmentImage, chat.AttachmentDocument}, true
+	}
+	return nil, false
+}
+
+// parseSearchDate tanggal YYYY-MM-DD (UTC) atau waktu RFC3339, tanggal pada until
+// mencakup seluruh hari tersebut
+func parseSearchDate(value string, until bool) (*time.Time, bool) {
+	if value == "" {
+		return nil, true
+	}
+
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		if until {
+			t = t.AddDate(0, 0, 1)
+		}
+		return &t, true
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, true
+	}
+	return nil, false
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/app/models"
+	repo "github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/imaging"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ResolveDismiss gambar bukan duplikat, badge peringatan dihapus
+	ResolveDismiss = "dismiss"
+	// ResolveRemove gambar terbukti diambil dari product lain, product dihapus
+	ResolveRemove = "remove"
+)
+
+type (
+	// ModerationService api implementation untuk moderasi product oleh admin
+	ModerationService struct {
+		sync.Mutex
+		productRepo *repo.ProductRepository
+	}
+
+	// FlaggedImage product beserta gambar yang dibandingkan pada flag duplikat
+	FlaggedImage struct {
+		ProductID   int64               `json:"product_id"`
+		ProductName string              `json:"product_name"`
+		StoreID     int64               `json:"store_id"`
+		Image       models.ProductImage `json:"image"`
+	}
+
+	// DuplicateFlag flag gambar duplikat yang menunggu keputusan admin
+	DuplicateFlag struct {
+		ID        int64        `json:"id"`
+		Distance  int          `json:"distance"`
+		Product   FlaggedImage `json:"product"`
+		Matched   FlaggedImage `json:"matched"`
+		CreatedAT *time.Time   `json:"created_at"`
+	}
+
+	// ResolveDuplicateQuery definisi query untuk memutuskan flag gambar duplikat
+	ResolveDuplicateQuery struct {
+		ID     int64  `json:"id" binding:"required"`
+		Action string `json:"action" binding:"required,oneof=dismiss remove"`
+	}
+)
+
+// NewModerationService instance
+// @RouterGroup /moderation/v1
+func NewModerationService() *ModerationService {
+	return &ModerationService{
+		productRepo: repo.NewProductRepository(),
+	}
+}
+
+// ListDuplicateFlags docs
+// @Tags ModerationService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan gambar product yang mirip dengan product store lain, khusus admin
+// @Accept json
+// @Produce json
+// @Param limit query int true "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} app.Result{result=EntriesResult{entries=[]DuplicateFlag}}
+// @Failure 400 {object} app.Result
+// @Router /duplicate-flags [get] [auth]
+func (s *ModerationService) ListDuplicateFlags(c *gin.Context, query *QueryEntries) {
+	if mid.CurrentUser.Type != models.UserTypeAdmin {
+		APIResult.Error(c, http.StatusForbidden, "Hanya admin yang dapat memoderasi product")
+		return
+	}
+
+	flags, count, err := s.productRepo.GetPendingDuplicateFlags(query.Offset, query.Limit)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat mendapatkan list gambar duplikat")
+		return
+	}
+
+	entries := []DuplicateFlag{}
+	for _, flag := range flags {
+		entries = append(entries, DuplicateFlag{
+			ID:        flag.ID,
+			Distance:  flag.Distance,
+			Product:   s.flaggedImage(flag.ProductID, flag.ImageID),
+			Matched:   s.flaggedImage(flag.MatchedProductID, flag.MatchedImageID),
+			CreatedAT: flag.CreatedAT,
+		})
+	}
+
+	APIResult.Success(c, EntriesResult{entries, count})
+}
+
+// ResolveDuplicateFlag docs
+// @Tags ModerationService
+// @Security bearerAuth
+// @Summary Endpoint untuk memutuskan flag gambar duplikat, dismiss menghapus badge peringatan dan remove menghapus product
+// @Accept json
+// @Produce json
+// @Param id body int true "ID"
+// @Param action body string true "Action"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /resolve-duplicate [post] [auth]
+func (s *ModerationService) ResolveDuplicateFlag(c *gin.Context, query *ResolveDuplicateQuery) {
+	if mid.CurrentUser.Type != models.UserTypeAdmin {
+		APIResult.Error(c, http.StatusForbidden, "Hanya admin yang dapat memoderasi product")
+		return
+	}
+
+	flag, err := s.productRepo.GetDuplicateFlag(query.ID)
+	if err != nil || flag.ResolvedAT != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Flag tidak ditemukan atau sudah diputuskan")
+		return
+	}
+
+	if query.Action == ResolveDismiss {
+		if err := s.productRepo.DismissDuplicateFlag(flag.ID, mid.CurrentUser.ID); err != nil {
+			APIResult.Error(c, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		APIResult.Success(c, nil)
+		return
+	}
+
+	// flag lain milik product ikut terhapus bersama product
+	product, err := s.productRepo.GetByID(flag.ProductID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Produk tidak ditemukan")
+		return
+	}
+	images, _ := s.productRepo.GetProductImages(product.ID)
+	if err := s.productRepo.DeleteProduct(product.ID, product.StoreID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	imaging.RemoveObjects(images...)
+
+	APIResult.Success(c, nil)
+}
+
+func (s *ModerationService) flaggedImage(productID int64, imageID int64) FlaggedImage {
+	product, _ := s.productRepo.GetByID(productID)
+	image, _ := s.productRepo.GetProductImage(imageID)
+
+	return FlaggedImage{
+		ProductID:   product.ID,
+		ProductName: product.ProductName,
+		StoreID:     product.StoreID,
+		Image:       image,
+	}
+}
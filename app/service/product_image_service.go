@@ -0,0 +1,84 @@
+package service
+
+import (
+	"net/http"
+
+	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/system/imaging"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// ProductImageService api implementation untuk upload dan menampilkan gambar product
+	ProductImageService struct{}
+
+	// QueryImageFile request type struct, key berasal dari url gambar product
+	QueryImageFile struct {
+		Key string `form:"key" binding:"required"`
+	}
+)
+
+// NewProductImageService instance
+// @RouterGroup /product-image/v1
+func NewProductImageService() *ProductImageService {
+	return &ProductImageService{}
+}
+
+// UploadProductImage docs
+// @Tags ProductImageService
+// @Security bearerAuth
+// @Summary Endpoint untuk upload gambar product (jpeg atau png), pasang ke product dengan image_ids pada add atau update product
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "File"
+// @Success 200 {object} app.Result{result=models.ProductImage}
+// @Failure 400 {object} app.Result
+// @Router /upload [post] [auth] [nolock]
+func (s *ProductImageService) UploadProductImage(c *gin.Context) {
+	// sisakan ruang untuk boundary multipart
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, imaging.MaxImageSize+(1<<20))
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, imaging.ErrImageTooLarge.Error())
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "File tidak dapat dibaca")
+		return
+	}
+	defer file.Close()
+
+	image, err := imaging.UploadProductImage(mid.CurrentUser.ID, file, header.Size)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, image)
+}
+
+// ProductImageFile docs
+// @Tags ProductImageService
+// @Summary Endpoint untuk menampilkan gambar product beserta variant-nya
+// @Produce image/jpeg
+// @Param key query string true "Key"
+// @Success 200 {file} file
+// @Failure 400 {object} app.Result
+// @Router /file [get] [nolock]
+func (s *ProductImageService) ProductImageFile(c *gin.Context, query *QueryImageFile) {
+	file, contentType, err := imaging.OpenProductImage(query.Key)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer file.Close()
+
+	// key object selalu baru untuk setiap upload sehingga isinya tidak pernah berubah
+	c.DataFromReader(http.StatusOK, -1, contentType, file, map[string]string{
+		"Cache-Control":          "public, max-age=31536000, immutable",
+		"X-Content-Type-Options": "nosniff",
+	})
+}
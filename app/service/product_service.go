@@ -14,8 +14,10 @@ import (
 	repo "github.com/fatkhur1960/goauction/app/repository"
 	"github.com/fatkhur1960/goauction/app/types"
 	"github.com/fatkhur1960/goauction/system/event"
-	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/fatkhur1960/goauction/system/imaging"
+	"github.com/fatkhur1960/goauction/system/monitor"
 	"github.com/gin-gonic/gin"
+	"github.com/jinzhu/gorm"
 )
 
 type (
@@ -24,6 +26,7 @@ type (
 		sync.Mutex
 		productRepo *repo.ProductRepository
 		storeRepo   *repo.StoreRepository
+		userRepo    *repo.UserRepository
 		event       *event.Listener
 	}
 
@@ -46,7 +49,8 @@ func NewProductService() *ProductService {
 	return &ProductService{
 		productRepo: repo.NewProductRepository(),
 		storeRepo:   repo.NewStoreRepository(),
-		event:       event.NewListener(queue.JobQueue),
+		userRepo:    repo.NewUserRepository(),
+		event:       event.NewListener(event.DefaultBus),
 	}
 }
 
@@ -56,7 +60,7 @@ func NewProductService() *ProductService {
 // @Summary Endpoint untuk menambahkan product
 // @Accept json
 // @Param product_name body string true "ProductName"
-// @Param product_images body []string true "ProductImages"
+// @Param image_ids body []int true "ImageIDs"
 // @Param desc body string true "Desc"
 // @Param condition body int true "Condition"
 // @Param condition_avg body int true "ConditionAvg"
@@ -78,11 +82,14 @@ func (s *ProductService) AddProduct(c *gin.Context, query *repo.NewProductQuery)
 		return
 	}
 
+	query.OwnerID = mid.CurrentUser.ID
 	product, err := s.productRepo.CreateProduct(*query)
 	if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
 	}
+	monitor.ScheduleProductClose(&product)
+	s.flagDuplicateImages(product.ID)
 
 	APIResult.Success(c, product.ToAPI(&mid.CurrentUser.ID))
 }
@@ -207,7 +214,7 @@ func (s *ProductService) DetailProduct(c *gin.Context, query *IDQuery) {
 // @Produce json
 // @Param id body int true "ID"
 // @Param product_name body string true "ProductName"
-// @Param product_images body []string true "ProductImages"
+// @Param image_ids body []int true "ImageIDs"
 // @Param desc body string true "Desc"
 // @Param condition body int true "Condition"
 // @Param condition_avg body int true "ConditionAvg"
@@ -227,6 +234,9 @@ func (s *ProductService) UpdateProduct(c *gin.Context, query *repo.UpdateProduct
 	if err != nil {
 		APIResult.Error(c, http.StatusNoContent, "Produk tidak ditemukan")
 		return
+	} else if parseTimeError != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Invalid datetime format. Correct format is like "+time.RFC3339)
+		return
 	} else if store.OwnerID != mid.CurrentUser.ID {
 		APIResult.Error(c, http.StatusBadRequest, "Unauthorized")
 		return
@@ -238,12 +248,18 @@ func (s *ProductService) UpdateProduct(c *gin.Context, query *repo.UpdateProduct
 		return
 	}
 
-	product, err := s.productRepo.UpdateProduct(query.ID, *query)
+	query.OwnerID = mid.CurrentUser.ID
+	product, removed, err := s.productRepo.UpdateProduct(query.ID, *query)
 	if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
-	} else if parseTimeError != nil {
-		log.Fatalf("ParseTime] error parsing time %v", parseTimeError.Error())
+	}
+	// file gambar baru dihapus setelah update berhasil, update yang gagal tidak melepas gambar apapun
+	imaging.RemoveObjects(removed...)
+	s.flagDuplicateImages(product.ID)
+	monitor.ScheduleProductClose(&product)
+	if updateTime.After(*p.ClosedAT) {
+		s.event.Emmit(&event.AuctionExtendedEvent{Product: product})
 	}
 
 	APIResult.Success(c, product.ToAPI(&mid.CurrentUser.ID))
@@ -271,10 +287,12 @@ func (s *ProductService) DeleteProduct(c *gin.Context, query *IDQuery) {
 		return
 	}
 
+	images, _ := s.productRepo.GetProductImages(product.ID)
 	if err := s.productRepo.DeleteProduct(product.ID, store.ID); err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
 	}
+	imaging.RemoveObjects(images...)
 
 	APIResult.Success(c, nil)
 }
@@ -289,6 +307,7 @@ func (s *ProductService) DeleteProduct(c *gin.Context, query *IDQuery) {
 // @Param bid_price body number true "BidPrice"
 // @Success 200 {object} app.Result{result=models.ProductBidder}
 // @Failure 400 {object} app.Result
+// @Failure 403 {object} app.Result
 // @Router /bidder/add [post] [auth]
 func (s *ProductService) BidProduct(c *gin.Context, query *BidProductQuery) {
 	product, err1 := s.productRepo.GetByID(query.ProductID)
@@ -303,6 +322,9 @@ func (s *ProductService) BidProduct(c *gin.Context, query *BidProductQuery) {
 	} else if err1 != nil {
 		APIResult.Error(c, http.StatusBadRequest, "Bid tidak ditemukan")
 		return
+	} else if s.userRepo.HasBlocked(store.OwnerID, mid.CurrentUser.ID) {
+		APIResult.Error(c, http.StatusForbidden, "Anda tidak dapat melakukan bid pada product ini")
+		return
 	} else if (int(query.BidPrice) % int(product.BidMultpl)) != 0 {
 		APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Bid tidak termasuk kelipatan %v", product.BidMultpl))
 		return
@@ -311,17 +333,17 @@ func (s *ProductService) BidProduct(c *gin.Context, query *BidProductQuery) {
 		return
 	}
 
-	bidder, err := s.productRepo.AddProductBidder(mid.CurrentUser.ID, query.ProductID, query.BidPrice)
-	if err != nil {
-		APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Error: %s", err.Error()))
-	}
-
-	{
-		go s.event.Emmit(&event.UserBidProductEvent{
-			User:    &mid.CurrentUser,
+	user := mid.CurrentUser
+	bidder, err := s.productRepo.AddProductBidder(user.ID, query.ProductID, query.BidPrice, func(tx *gorm.DB, bidder models.ProductBidder) error {
+		return s.event.EmmitTx(tx, &event.UserBidProductEvent{
+			User:    &user,
 			Product: product,
-			BidData: bidder.(models.ProductBidder),
+			BidData: bidder,
 		})
+	})
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Error: %s", err.Error()))
+		return
 	}
 
 	APIResult.Success(c, bidder)
@@ -380,18 +402,18 @@ func (s *ProductService) ReOpenProductBid(c *gin.Context, query *ReOpenBidQuery)
 	} else if !p.Closed {
 		APIResult.Error(c, http.StatusBadRequest, "Bid belum ditutup")
 		return
-	} else if updatedTime.Sub(*p.ClosedAT) <= 0 {
+	} else if parseTimeError != nil || updatedTime.Sub(*p.ClosedAT) <= 0 {
 		APIResult.Error(c, http.StatusBadRequest, "Waktu ditutup tidak valid")
 		return
 	}
 
-	product, err2 := s.productRepo.ReOpenBid(query.ProductID, query.ClosedAT)
-	if err2 != nil {
+	product, err := s.productRepo.ReOpenBid(query.ProductID, query.ClosedAT)
+	if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
-	} else if parseTimeError != nil {
-		log.Fatalf("ParseTime] error parsing time %v", parseTimeError.Error())
 	}
+	monitor.ScheduleProductClose(&product)
+	s.event.Emmit(&event.AuctionExtendedEvent{Product: product})
 
 	APIResult.Success(c, product)
 }
@@ -424,7 +446,9 @@ func (s *ProductService) MarkProductAsSold(c *gin.Context, query *IDQuery) {
 		return
 	}
 
-	product, err := s.productRepo.SetProductSold(query.ID)
+	product, err := s.productRepo.SetProductSold(query.ID, func(tx *gorm.DB, product models.Product) error {
+		return s.event.EmmitTx(tx, &event.ProductSoldEvent{Product: product})
+	})
 	if err != nil {
 		APIResult.Error(c, http.StatusBadRequest, err.Error())
 		return
@@ -432,3 +456,14 @@ func (s *ProductService) MarkProductAsSold(c *gin.Context, query *IDQuery) {
 
 	APIResult.Success(c, product)
 }
+
+// flagDuplicateImages flag gambar product yang mirip gambar product store lain,
+// gagal flag tidak membatalkan add atau update product
+func (s *ProductService) flagDuplicateImages(productID int64) {
+	count, err := s.productRepo.FlagDuplicateImages(productID, imaging.DuplicateDistance)
+	if err != nil {
+		log.Printf("Moderation] flag duplicate images product #%d error: %s\n", productID, err.Error())
+	} else if count > 0 {
+		log.Printf("Moderation] product #%d has %d duplicate images\n", productID, count)
+	}
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/system/socket"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// StreamService api implementation untuk Server-Sent Events
+	StreamService struct{}
+
+	// QueryStream request type struct, token dipakai client yang tidak bisa mengirim header
+	// Authorization, eg: EventSource di browser
+	QueryStream struct {
+		Token       string  `form:"token"`
+		ProductIDs  []int64 `form:"product_id"`
+		LastEventID string  `form:"last_event_id"`
+	}
+)
+
+// NewStreamService instance
+// @RouterGroup /stream/v1
+func NewStreamService() *StreamService {
+	return &StreamService{}
+}
+
+// StreamEvents docs
+// @Tags StreamService
+// @Security bearerAuth
+// @Summary Endpoint text/event-stream berisi notif, message chat dan event lelang product yang diikuti
+// @Produce text/event-stream
+// @Param token query string false "Token"
+// @Param product_id query []int false "ProductID"
+// @Param last_event_id query string false "LastEventID"
+// @Success 200 {string} string
+// @Failure 400 {object} app.Result
+// @Failure 401 {object} app.Result
+// @Router /events [get] [nolock]
+func (s *StreamService) StreamEvents(c *gin.Context, query *QueryStream) {
+	token := query.Token
+	if token == "" {
+		token = strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	}
+	user, err := mid.Authenticate(token)
+	if err != nil {
+		APIResult.Error(c, http.StatusUnauthorized, err.Error())
+		return
+	}
+
+	if len(query.ProductIDs) > socket.MaxStreamProducts {
+		APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Maksimal %d product", socket.MaxStreamProducts))
+		return
+	}
+
+	if err := socket.ServeEvents(c.Writer, c.Request, user, query.ProductIDs); err != nil {
+		log.Printf("StreamService] Stream for %s error: %s\n", user.FullName, err.Error())
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat membuka stream")
+	}
+}
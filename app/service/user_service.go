@@ -1,19 +1,30 @@
 package service
 
 import (
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"sync"
+	"time"
 
 	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/app/models"
 	repo "github.com/fatkhur1960/goauction/app/repository"
 	"github.com/fatkhur1960/goauction/app/types"
 	"github.com/fatkhur1960/goauction/app/utils"
+	"github.com/fatkhur1960/goauction/system/core"
 	"github.com/fatkhur1960/goauction/system/event"
-	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/fatkhur1960/goauction/system/notificator"
 	"github.com/gin-gonic/gin"
 )
 
+// maxNotifLimit batas jumlah notif dalam satu halaman
+const maxNotifLimit = 100
+
+// defaultNotifTimezone timezone quiet hours jika user tidak memilih
+const defaultNotifTimezone = "Asia/Jakarta"
+
 type (
 	// UserService implementation for users
 	UserService struct {
@@ -44,6 +55,26 @@ type (
 		NotifIds []int64 `json:"notif_ids" binding:"required"`
 	}
 
+	// QueryNotifs definisi query list notif, before adalah next_cursor dari halaman sebelumnya
+	QueryNotifs struct {
+		Limit     int   `form:"limit" binding:"required"`
+		Before    int64 `form:"before"`
+		NotifType *int  `form:"notif_type"`
+		Read      *bool `form:"read"`
+	}
+
+	// NotifEntriesResult result list notif dengan cursor untuk halaman berikutnya,
+	// next_cursor 0 berarti tidak ada halaman berikutnya
+	NotifEntriesResult struct {
+		Entries    []models.UserNotif `json:"entries"`
+		NextCursor int64              `json:"next_cursor"`
+	}
+
+	// UnreadCountResult jumlah notif yang belum dibaca
+	UnreadCountResult struct {
+		Count int `json:"count"`
+	}
+
 	// BecomeAuctioneerQuery definisi query untuk upgrade user
 	BecomeAuctioneerQuery struct {
 		Name        string `json:"name" binding:"required"`
@@ -55,10 +86,66 @@ type (
 		Address     string `json:"address" binding:"required"`
 	}
 
-	// ConnectCreateQuery definisi query untuk membuat app id
+	// LocaleQuery definisi query untuk memilih bahasa notif, eg: id, en
+	LocaleQuery struct {
+		Locale string `json:"locale" binding:"required"`
+	}
+
+	// ConnectCreateQuery definisi query untuk membuat app id,
+	// dipanggil setiap app dibuka untuk memperbarui last seen device
 	ConnectCreateQuery struct {
 		AppID        string `json:"app_id" binding:"required"`
 		ProviderName string `json:"provider_name" binding:"required"`
+		AppVersion   string `json:"app_version"`
+	}
+
+	// ConnectRemoveQuery definisi query untuk menghapus app id,
+	// app id kosong berarti hapus semua device user
+	ConnectRemoveQuery struct {
+		AppID string `json:"app_id"`
+	}
+
+	// NotifPreferenceQuery definisi query untuk memilih channel notif type,
+	// channels kosong berarti tidak menerima notif selain di inbox
+	NotifPreferenceQuery struct {
+		NotifType *int     `json:"notif_type" binding:"required"`
+		Channels  []string `json:"channels"`
+	}
+
+	// QuietHoursQuery definisi query untuk quiet hours, start dan end kosong untuk menonaktifkan
+	QuietHoursQuery struct {
+		Start    string `json:"start"`
+		End      string `json:"end"`
+		Timezone string `json:"timezone"`
+	}
+
+	// NotifPreference channel yang digunakan untuk notif type
+	NotifPreference struct {
+		NotifType int      `json:"notif_type"`
+		Channels  []string `json:"channels"`
+	}
+
+	// DailyDigestQuery definisi query untuk memilih email ringkasan harian
+	DailyDigestQuery struct {
+		Enabled *bool `json:"enabled" binding:"required"`
+	}
+
+	// BlockUserQuery definisi query untuk memblokir atau membuka blokir user
+	BlockUserQuery struct {
+		UserID int64 `json:"user_id" binding:"required"`
+	}
+
+	// BlockedUser user pada block list beserta waktu diblokir
+	BlockedUser struct {
+		User      *models.UserSimple `json:"user"`
+		BlockedAT *time.Time         `json:"blocked_at"`
+	}
+
+	// NotifSettings result preferensi notif user
+	NotifSettings struct {
+		Preferences []NotifPreference `json:"preferences"`
+		QuietHours  QuietHoursQuery   `json:"quiet_hours"`
+		DailyDigest bool              `json:"daily_digest"`
 	}
 )
 
@@ -71,7 +158,7 @@ func NewUserService() *UserService {
 		notifRepo:     repo.NewNotifRepository(),
 		authRepo:      repo.NewAuthRepository(),
 		productRepo:   repo.NewProductRepository(),
-		eventListener: event.NewListener(queue.JobQueue),
+		eventListener: event.NewListener(event.DefaultBus),
 	}
 }
 
@@ -102,7 +189,7 @@ func (s *UserService) RegisterUser(c *gin.Context, query *RegisterUserQuery) {
 
 	// Emmit register event
 	{
-		go s.eventListener.Emmit(event.UserRegisteredEvent{
+		s.eventListener.Emmit(event.UserRegisteredEvent{
 			FullName: user.FullName,
 			Email:    user.Email,
 			PhoneNum: user.PhoneNum,
@@ -172,6 +259,30 @@ func (s *UserService) UpdateUserInfo(c *gin.Context, query *repo.UpdateUserQuery
 	APIResult.Success(c, user)
 }
 
+// SetUserLocale docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk memilih bahasa (id, en) yang digunakan pada notif
+// @Accept json
+// @Produce json
+// @Param locale body string true "Locale"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /me/locale [post] [auth]
+func (s *UserService) SetUserLocale(c *gin.Context, query *LocaleQuery) {
+	if !notificator.ValidLocale(query.Locale) {
+		APIResult.Error(c, http.StatusBadRequest, "Locale tidak valid")
+		return
+	}
+
+	if err := s.userRepo.SetLocale(mid.CurrentUser.ID, query.Locale); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menyimpan locale")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
 // GetUserStore docs
 // @Tags UserService
 // @Summary Endpoint untuk mendapatkan user store
@@ -253,11 +364,12 @@ func (s *UserService) ListUserBids(c *gin.Context, query *QueryEntries) {
 // @Produce json
 // @Param app_id body string true "AppID"
 // @Param provider_name body string true "ProviderName"
+// @Param app_version body string false "AppVersion"
 // @Success 200 {object} app.Result
 // @Failure 400 {object} app.Result
 // @Router /connect-create [post] [auth]
 func (s *UserService) ConnectCreate(c *gin.Context, query *ConnectCreateQuery) {
-	err := s.userRepo.CreateUserConnect(mid.CurrentUser.ID, query.AppID, query.ProviderName)
+	err := s.userRepo.CreateUserConnect(mid.CurrentUser.ID, query.AppID, query.ProviderName, query.AppVersion)
 	if err != nil {
 		log.Printf("UserService] ConnectCreate error: %s", err.Error())
 		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuat app id")
@@ -271,11 +383,18 @@ func (s *UserService) ConnectCreate(c *gin.Context, query *ConnectCreateQuery) {
 // @Tags UserService
 // @Security bearerAuth
 // @Summary Endpoint untuk menghapus app id dari db
+// @Produce json
+// @Param app_id body string false "AppID"
 // @Success 200 {object} app.Result
 // @Failure 400 {object} app.Result
 // @Router /connect-remove [post] [auth]
-func (s *UserService) ConnectRemove(c *gin.Context) {
-	err := s.userRepo.RemoveUserConnect(mid.CurrentUser.ID)
+func (s *UserService) ConnectRemove(c *gin.Context, query *ConnectRemoveQuery) {
+	var err error
+	if query.AppID != "" {
+		err = s.userRepo.RemoveUserConnect(mid.CurrentUser.ID, query.AppID)
+	} else {
+		err = s.userRepo.RemoveUserConnects(mid.CurrentUser.ID)
+	}
 	if err != nil {
 		log.Printf("UserService] ConnectRemove error: %s", err.Error())
 		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menghapus app id")
@@ -288,22 +407,51 @@ func (s *UserService) ConnectRemove(c *gin.Context) {
 // ListUserNotifs docs
 // @Tags UserService
 // @Security bearerAuth
-// @Summary Endpoint untuk mendapatkan list notif untuk current user
+// @Summary Endpoint untuk mendapatkan list notif untuk current user, diurutkan dari yang terbaru
 // @Produce json
 // @Param limit query int true "Limit"
-// @Param offset query int true "Offset"
-// @Param query query string false "Query"
-// @Param filter query string false "Filter"
-// @Success 200 {object} app.Result{result=EntriesResult{entries=[]models.UserNotif}}
+// @Param before query int false "Before"
+// @Param notif_type query int false "NotifType"
+// @Param read query bool false "Read"
+// @Success 200 {object} app.Result{result=NotifEntriesResult}
 // @Failure 400 {object} app.Result
 // @Router /notifs [get] [auth]
-func (s *UserService) ListUserNotifs(c *gin.Context, query *QueryEntries) {
-	entries, count, err := s.notifRepo.GetUserNotif(mid.CurrentUser.ID, query.Offset, query.Limit)
+func (s *UserService) ListUserNotifs(c *gin.Context, query *QueryNotifs) {
+	if query.Limit > maxNotifLimit {
+		query.Limit = maxNotifLimit
+	}
+
+	filter := repo.NotifFilter{NotifType: query.NotifType, Read: query.Read}
+	entries, err := s.notifRepo.GetUserNotifs(mid.CurrentUser.ID, filter, query.Before, query.Limit)
 	if err != nil {
-		log.Fatal(err)
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat mendapatkan notif")
+		return
 	}
 
-	APIResult.Success(c, EntriesResult{entries, count})
+	result := NotifEntriesResult{Entries: entries}
+	if len(entries) == query.Limit {
+		result.NextCursor = entries[len(entries)-1].ID
+	}
+
+	APIResult.Success(c, result)
+}
+
+// UnreadNotifCount docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk mendapatkan jumlah notif yang belum dibaca, digunakan untuk badge
+// @Produce json
+// @Success 200 {object} app.Result{result=UnreadCountResult}
+// @Failure 400 {object} app.Result
+// @Router /notifs/unread-count [get] [auth]
+func (s *UserService) UnreadNotifCount(c *gin.Context) {
+	count, err := s.notifRepo.CountUnread(mid.CurrentUser.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menghitung notif")
+		return
+	}
+
+	APIResult.Success(c, UnreadCountResult{count})
 }
 
 // MarkAsReadNotif docs
@@ -324,3 +472,259 @@ func (s *UserService) MarkAsReadNotif(c *gin.Context, query *ReadNotifQuery) {
 
 	APIResult.Success(c, nil)
 }
+
+// MarkAllAsReadNotif docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary endpoint untuk menandai semua notif sudah terbaca
+// @Produce json
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /notifs/read-all [post] [auth]
+func (s *UserService) MarkAllAsReadNotif(c *gin.Context) {
+	if err := s.notifRepo.MarkAllAsRead(mid.CurrentUser.ID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// DeleteNotifs docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary endpoint untuk menghapus notif
+// @Produce json
+// @Param notif_ids body []int true "NotifIds"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /notifs/delete [post] [auth]
+func (s *UserService) DeleteNotifs(c *gin.Context, query *ReadNotifQuery) {
+	if err := s.notifRepo.DeleteNotifs(query.NotifIds, mid.CurrentUser.ID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// GetNotifSettings docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk mendapatkan preferensi channel dan quiet hours notif
+// @Produce json
+// @Success 200 {object} app.Result{result=NotifSettings}
+// @Failure 400 {object} app.Result
+// @Router /notifs/settings [get] [auth]
+func (s *UserService) GetNotifSettings(c *gin.Context) {
+	saved, err := s.notifRepo.GetPreferences(mid.CurrentUser.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result := NotifSettings{
+		Preferences: []NotifPreference{},
+		QuietHours:  QuietHoursQuery{Timezone: defaultNotifTimezone},
+	}
+	for _, notifType := range core.NotifTypes {
+		preference := NotifPreference{
+			NotifType: int(notifType),
+			Channels:  notificator.DefaultChannels(notifType),
+		}
+		for _, p := range saved {
+			if p.NotifType == int(notifType) {
+				preference.Channels = []string{}
+				if p.Channels != "" {
+					preference.Channels = strings.Split(p.Channels, ",")
+				}
+			}
+		}
+		result.Preferences = append(result.Preferences, preference)
+	}
+
+	if setting, err := s.notifRepo.GetSetting(mid.CurrentUser.ID); err == nil {
+		result.QuietHours = QuietHoursQuery{
+			Start:    setting.QuietStart,
+			End:      setting.QuietEnd,
+			Timezone: setting.Timezone,
+		}
+		result.DailyDigest = setting.DailyDigest
+	}
+
+	APIResult.Success(c, result)
+}
+
+// SetNotifPreference docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk memilih channel (push, email, socket, webhook) untuk notif type
+// @Accept json
+// @Produce json
+// @Param notif_type body int true "NotifType"
+// @Param channels body []string true "Channels"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /notifs/preference [post] [auth]
+func (s *UserService) SetNotifPreference(c *gin.Context, query *NotifPreferenceQuery) {
+	notifType := core.NotifType(*query.NotifType)
+	if !notifType.Valid() {
+		APIResult.Error(c, http.StatusBadRequest, "Notif type tidak valid")
+		return
+	}
+
+	for _, channel := range query.Channels {
+		if !notificator.ValidChannel(channel) {
+			APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Channel `%s` tidak valid", channel))
+			return
+		}
+	}
+
+	if err := s.notifRepo.SetPreference(mid.CurrentUser.ID, notifType, query.Channels); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menyimpan preferensi notif")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// SetQuietHours docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk mengatur quiet hours, push dan email tidak dikirim selama quiet hours
+// @Accept json
+// @Produce json
+// @Param start body string true "Start"
+// @Param end body string true "End"
+// @Param timezone body string false "Timezone"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /notifs/quiet-hours [post] [auth]
+func (s *UserService) SetQuietHours(c *gin.Context, query *QuietHoursQuery) {
+	if query.Timezone == "" {
+		query.Timezone = defaultNotifTimezone
+	}
+
+	if (query.Start == "") != (query.End == "") {
+		APIResult.Error(c, http.StatusBadRequest, "Start dan end harus diisi bersamaan")
+		return
+	} else if query.Start != "" {
+		if _, err := notificator.ParseClock(query.Start); err != nil {
+			APIResult.Error(c, http.StatusBadRequest, "Format start harus HH:MM")
+			return
+		} else if _, err := notificator.ParseClock(query.End); err != nil {
+			APIResult.Error(c, http.StatusBadRequest, "Format end harus HH:MM")
+			return
+		}
+	}
+
+	// default timezone tetap diterima walau tzdata tidak tersedia, notificator fallback ke WIB
+	if _, err := time.LoadLocation(query.Timezone); err != nil && query.Timezone != defaultNotifTimezone {
+		APIResult.Error(c, http.StatusBadRequest, "Timezone tidak valid")
+		return
+	}
+
+	err := s.notifRepo.SetQuietHours(mid.CurrentUser.ID, query.Start, query.End, query.Timezone)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menyimpan quiet hours")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// SetDailyDigest docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk memilih menerima email ringkasan harian bid, lelang yang dimenangkan dan lelang yang segera ditutup
+// @Accept json
+// @Produce json
+// @Param enabled body bool true "Enabled"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /notifs/digest [post] [auth]
+func (s *UserService) SetDailyDigest(c *gin.Context, query *DailyDigestQuery) {
+	if err := s.notifRepo.SetDailyDigest(mid.CurrentUser.ID, *query.Enabled); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menyimpan pengaturan ringkasan harian")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// BlockUser docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk memblokir user, user yang diblokir tidak dapat chat, bid product maupun mengirim notif ke current user
+// @Accept json
+// @Produce json
+// @Param user_id body int true "UserID"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /block [post] [auth]
+func (s *UserService) BlockUser(c *gin.Context, query *BlockUserQuery) {
+	if query.UserID == mid.CurrentUser.ID {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat memblokir diri sendiri")
+		return
+	}
+	if _, err := s.userRepo.GetByID(query.UserID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "User tidak ditemukan")
+		return
+	}
+
+	if err := s.userRepo.BlockUser(mid.CurrentUser.ID, query.UserID); err != nil {
+		log.Printf("UserService] BlockUser error: %s", err.Error())
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat memblokir user")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// UnblockUser docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk membuka blokir user
+// @Accept json
+// @Produce json
+// @Param user_id body int true "UserID"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /unblock [post] [auth]
+func (s *UserService) UnblockUser(c *gin.Context, query *BlockUserQuery) {
+	if err := s.userRepo.UnblockUser(mid.CurrentUser.ID, query.UserID); err != nil {
+		log.Printf("UserService] UnblockUser error: %s", err.Error())
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuka blokir user")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// ListBlockedUsers docs
+// @Tags UserService
+// @Security bearerAuth
+// @Summary Endpoint untuk mendapatkan list user yang diblokir current user
+// @Produce json
+// @Param limit query int true "Limit"
+// @Param offset query int false "Offset"
+// @Success 200 {object} app.Result{result=EntriesResult{entries=[]BlockedUser}}
+// @Failure 400 {object} app.Result
+// @Router /blocks [get] [auth]
+func (s *UserService) ListBlockedUsers(c *gin.Context, query *QueryEntries) {
+	blocks, count, err := s.userRepo.GetBlockedUsers(mid.CurrentUser.ID, query.Offset, query.Limit)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat mendapatkan list user yang diblokir")
+		return
+	}
+
+	entries := []BlockedUser{}
+	for _, block := range blocks {
+		entries = append(entries, BlockedUser{
+			User:      s.userRepo.UserSimple(block.BlockedID),
+			BlockedAT: block.CreatedAT,
+		})
+	}
+
+	APIResult.Success(c, EntriesResult{entries, count})
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	mid "github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/app/models"
+	repo "github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+type (
+	// WebhookService api implementation untuk webhook store
+	WebhookService struct {
+		sync.Mutex
+		webhookRepo *repo.WebhookRepository
+		storeRepo   *repo.StoreRepository
+	}
+
+	// QueryDeliveries request type struct
+	QueryDeliveries struct {
+		WebhookID int64 `form:"webhook_id" binding:"required"`
+		Limit     int   `form:"limit" binding:"required"`
+		Offset    int   `form:"offset"`
+	}
+)
+
+// NewWebhookService instance
+// @RouterGroup /webhook/v1
+func NewWebhookService() *WebhookService {
+	return &WebhookService{
+		webhookRepo: repo.NewWebhookRepository(),
+		storeRepo:   repo.NewStoreRepository(),
+	}
+}
+
+// ownedWebhook mengambil webhook milik store current user
+func (s *WebhookService) ownedWebhook(c *gin.Context, webhookID int64) (models.StoreWebhook, bool) {
+	hook, err := s.webhookRepo.GetByID(webhookID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Webhook tidak ditemukan")
+		return hook, false
+	}
+
+	store, err := s.storeRepo.GetByID(hook.StoreID)
+	if err != nil || store.OwnerID != mid.CurrentUser.ID {
+		APIResult.Error(c, http.StatusUnauthorized, "Unauthorized")
+		return hook, false
+	}
+
+	return hook, true
+}
+
+// AddWebhook docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk menambahkan webhook store, secret hanya ditampilkan sekali
+// @Accept json
+// @Produce json
+// @Param url body string true "URL"
+// @Param event_types body []string true "EventTypes"
+// @Success 200 {object} app.Result{result=models.StoreWebhook}
+// @Failure 400 {object} app.Result
+// @Router /add [post] [auth]
+func (s *WebhookService) AddWebhook(c *gin.Context, query *repo.NewWebhookQuery) {
+	store, err := s.storeRepo.GetStoreByOwnerID(mid.CurrentUser.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Anda belum memiliki store")
+		return
+	}
+
+	if err := webhook.ValidateURL(query.URL); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	} else if len(query.EventTypes) == 0 {
+		APIResult.Error(c, http.StatusBadRequest, "Event types tidak boleh kosong")
+		return
+	}
+
+	for _, eventType := range query.EventTypes {
+		if !webhook.ValidEventType(eventType) {
+			APIResult.Error(c, http.StatusBadRequest, fmt.Sprintf("Event type `%s` tidak valid", eventType))
+			return
+		}
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		APIResult.Error(c, http.StatusInternalServerError, "Tidak dapat membuat webhook")
+		return
+	}
+
+	hook, err := s.webhookRepo.CreateWebhook(store.ID, secret, *query)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat membuat webhook")
+		return
+	}
+
+	APIResult.Success(c, hook)
+}
+
+// ListWebhooks docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan list webhook store
+// @Produce json
+// @Success 200 {object} app.Result{result=[]models.StoreWebhook}
+// @Failure 400 {object} app.Result
+// @Router /list [get] [auth]
+func (s *WebhookService) ListWebhooks(c *gin.Context) {
+	store, err := s.storeRepo.GetStoreByOwnerID(mid.CurrentUser.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Anda belum memiliki store")
+		return
+	}
+
+	hooks, err := s.webhookRepo.GetStoreWebhooks(store.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	for i := range hooks {
+		hooks[i].Secret = ""
+	}
+
+	APIResult.Success(c, hooks)
+}
+
+// DeleteWebhook docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk menghapus webhook
+// @Accept json
+// @Produce json
+// @Param id body int true "ID"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /delete [post] [auth]
+func (s *WebhookService) DeleteWebhook(c *gin.Context, query *IDQuery) {
+	if _, ok := s.ownedWebhook(c, query.ID); !ok {
+		return
+	}
+
+	if err := s.webhookRepo.DeleteWebhook(query.ID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat menghapus webhook")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// EnableWebhook docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk mengaktifkan lagi webhook yang dinonaktifkan
+// @Accept json
+// @Produce json
+// @Param id body int true "ID"
+// @Success 200 {object} app.Result
+// @Failure 400 {object} app.Result
+// @Router /enable [post] [auth]
+func (s *WebhookService) EnableWebhook(c *gin.Context, query *IDQuery) {
+	if _, ok := s.ownedWebhook(c, query.ID); !ok {
+		return
+	}
+
+	if err := s.webhookRepo.EnableWebhook(query.ID); err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat mengaktifkan webhook")
+		return
+	}
+
+	APIResult.Success(c, nil)
+}
+
+// ListDeliveries docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan log delivery webhook
+// @Produce json
+// @Param webhook_id query int true "WebhookID"
+// @Param limit query int true "Limit"
+// @Param offset query int true "Offset"
+// @Success 200 {object} app.Result{result=EntriesResult{entries=[]models.WebhookDelivery}}
+// @Failure 400 {object} app.Result
+// @Router /deliveries [get] [auth]
+func (s *WebhookService) ListDeliveries(c *gin.Context, query *QueryDeliveries) {
+	if _, ok := s.ownedWebhook(c, query.WebhookID); !ok {
+		return
+	}
+
+	entries, count, err := s.webhookRepo.GetDeliveries(query.WebhookID, query.Offset, query.Limit)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, EntriesResult{entries, count})
+}
+
+// ListDeliveryAttempts docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk menampilkan setiap percobaan pengiriman delivery
+// @Produce json
+// @Param id query int true "ID"
+// @Success 200 {object} app.Result{result=[]models.WebhookDeliveryAttempt}
+// @Failure 400 {object} app.Result
+// @Router /deliveries/attempts [get] [auth]
+func (s *WebhookService) ListDeliveryAttempts(c *gin.Context, query *IDQuery) {
+	delivery, err := s.webhookRepo.GetDeliveryByID(query.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Delivery tidak ditemukan")
+		return
+	} else if _, ok := s.ownedWebhook(c, delivery.WebhookID); !ok {
+		return
+	}
+
+	attempts, err := s.webhookRepo.GetDeliveryAttempts(delivery.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	APIResult.Success(c, attempts)
+}
+
+// Redeliver docs
+// @Tags WebhookService
+// @Security bearerAuth
+// @Summary Endpoint untuk mengirim ulang delivery webhook
+// @Accept json
+// @Produce json
+// @Param id body int true "ID"
+// @Success 200 {object} app.Result{result=models.WebhookDelivery}
+// @Failure 400 {object} app.Result
+// @Router /redeliver [post] [auth]
+func (s *WebhookService) Redeliver(c *gin.Context, query *IDQuery) {
+	delivery, err := s.webhookRepo.GetDeliveryByID(query.ID)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Delivery tidak ditemukan")
+		return
+	}
+
+	hook, ok := s.ownedWebhook(c, delivery.WebhookID)
+	if !ok {
+		return
+	} else if !hook.Active {
+		APIResult.Error(c, http.StatusBadRequest, "Webhook tidak aktif, aktifkan terlebih dahulu")
+		return
+	}
+
+	redelivery, err := webhook.Redeliver(&delivery)
+	if err != nil {
+		APIResult.Error(c, http.StatusBadRequest, "Tidak dapat mengirim ulang webhook")
+		return
+	}
+
+	APIResult.Success(c, redelivery)
+}
@@ -21,6 +21,8 @@ type (
 		Sold          bool        `json:"sold"`
 		Closed        bool        `json:"closed"`
 		BidStatus     interface{} `json:"bid_status"`
+		// DuplicateWarning gambar product mirip dengan product store lain dan belum diperiksa admin
+		DuplicateWarning bool `json:"duplicate_warning"`
 	}
 
 	// ProductDetail json
@@ -40,6 +42,8 @@ type (
 		Closed        bool        `json:"closed"`
 		Store         interface{} `json:"store"`
 		BidStatus     interface{} `json:"bid_status"`
+		// DuplicateWarning gambar product mirip dengan product store lain dan belum diperiksa admin
+		DuplicateWarning bool `json:"duplicate_warning"`
 	}
 
 	// Chat api type
@@ -47,8 +51,15 @@ type (
 		ID           int64       `json:"id"`
 		InitiatorID  int64       `json:"initiator_id"`
 		SubscriberID int64       `json:"subscriber_id"`
+		ProductID    *int64      `json:"product_id"`
 		LastUpdated  *time.Time  `json:"last_updated"`
 		TS           *time.Time  `json:"ts"`
 		Display      interface{} `json:"display"`
+		// Product card product yang terakhir dibicarakan, null jika room tanpa product
+		Product interface{} `json:"product"`
+		// Unread jumlah message untuk user yang belum dibaca
+		Unread int `json:"unread"`
+		// PartnerReadID id message terakhir yang sudah dibaca lawan chat
+		PartnerReadID int64 `json:"partner_read_id"`
 	}
 )
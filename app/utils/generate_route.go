@@ -23,11 +23,13 @@ type (
 		Child     []APIEndpoint
 	}
 
-	// APIEndpoint struct for api grouping
+	// APIEndpoint struct for api grouping,
+	// NoLock endpoint tidak menahan lock service, untuk handler yang berjalan lama (stream, transfer file)
 	APIEndpoint struct {
 		Name   string
 		Path   string
 		Auth   bool
+		NoLock bool
 		Method string
 		Param  interface{}
 	}
@@ -45,6 +47,7 @@ func parseEndpoint(name string, input string, param interface{}) APIEndpoint {
 	var path string
 	var method string
 	var auth = false
+	var noLock = false
 
 	for _, v := range args {
 		// find methods
@@ -57,6 +60,11 @@ func parseEndpoint(name string, input string, param interface{}) APIEndpoint {
 			auth = true
 		}
 
+		// find lock is disabled
+		if v == "[nolock]" {
+			noLock = true
+		}
+
 		// find path
 		if strings.HasPrefix(v, "/") {
 			path = strings.TrimSpace(v)
@@ -67,6 +75,7 @@ func parseEndpoint(name string, input string, param interface{}) APIEndpoint {
 		Name:   name,
 		Path:   path,
 		Auth:   auth,
+		NoLock: noLock,
 		Method: method,
 		Param:  param,
 	}
@@ -162,6 +171,11 @@ func GenerateRoutes() bool {
 
 		for _, e := range route.Child {
 			var validator string
+			var lock string
+			if !e.NoLock {
+				lock += fmt.Sprintf("\t\t\t\t%s.Lock()\n", varName)
+				lock += fmt.Sprintf("\t\t\t\tdefer %s.Unlock()\n", varName)
+			}
 			if e.Param != nil {
 				param := fmt.Sprintf("service.%s", e.Param)
 				bindType := "binding.JSON"
@@ -175,15 +189,13 @@ func GenerateRoutes() bool {
 				}
 
 				validator += "func(c *gin.Context) {\n"
-				validator += fmt.Sprintf("\t\t\t\t%s.Lock()\n", varName)
-				validator += fmt.Sprintf("\t\t\t\tdefer %s.Unlock()\n", varName)
+				validator += lock
 				validator += fmt.Sprintf("\t\t\t\tquery, err := mid.ReqValidate(c, &%s{}, %s)\n", param, bindType)
 				validator += fmt.Sprintf("\t\t\t\tif err != nil {\n\t\t\t\t\treturn\n\t\t\t\t}\n")
 				validator += fmt.Sprintf("\t\t\t\t%s.%s(c, query.(*%s))\n\t\t\t}", varName, e.Name, param)
 			} else {
 				validator += "func(c *gin.Context) {\n"
-				validator += fmt.Sprintf("\t\t\t\t%s.Lock()\n", varName)
-				validator += fmt.Sprintf("\t\t\t\tdefer %s.Unlock()\n", varName)
+				validator += lock
 				validator += fmt.Sprintf("\t\t\t\t%s.%s(c)\n", varName, e.Name)
 				validator += fmt.Sprint("\t\t\t\t}")
 			}
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/fatkhur1960/goauction/app"
@@ -17,8 +20,12 @@ import (
 	"github.com/fatkhur1960/goauction/system/socket"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	socketio "github.com/googollee/go-socket.io"
 )
 
+// shutdownTimeout batas waktu untuk graceful shutdown
+const shutdownTimeout = 30 * time.Second
+
 // @title GoAuction API
 // @version 1.0
 // @description Backend lelah online
@@ -30,6 +37,17 @@ import (
 // @name Authorization
 func main() {
 	log.SetPrefix("[")
+
+	// root context, dibatalkan ketika menerima SIGINT/SIGTERM
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+		log.Printf("Main] got signal %s, shutting down...\n", <-sig)
+		cancel()
+	}()
+
 	// generating routes
 	log.Println("RouteGenerator] generating routes...")
 	go utils.GenerateRoutes()
@@ -43,10 +61,10 @@ func main() {
 
 	// connect with database
 	app.ConnectDatabase()
-	defer app.CloseDatabase()
 
 	QueueDispatcher := queue.NewDispatcher(4)
 	QueueDispatcher.Run()
+	monitor.ScheduleJobs()
 	go monitor.StartMonitors()
 
 	gin.DebugPrintRouteFunc = func(httpMethod, absolutePath, handlerName string, _ int) {
@@ -55,7 +73,6 @@ func main() {
 
 	wsHandler := socket.Handler()
 	go wsHandler.Serve()
-	defer wsHandler.Close()
 
 	goauction := router.GetGeneratedRoutes(gin.Default())
 	goauction.Use(mid.MethodValidator())
@@ -67,10 +84,11 @@ func main() {
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
-	goauction.GET("/socket.io/*any", mid.RequiresUserAuth, gin.WrapH(wsHandler))
-	goauction.POST("/socket.io/*any", mid.RequiresUserAuth, gin.WrapH(wsHandler))
-	goauction.Handle("WS", "/socket.io/*any", mid.RequiresUserAuth, gin.WrapH(wsHandler))
-	goauction.Handle("WSS", "/socket.io/*any", mid.RequiresUserAuth, gin.WrapH(wsHandler))
+	// setiap koneksi socket diautentikasi sendiri dengan query `token` atau header Authorization
+	goauction.GET("/socket.io/*any", gin.WrapH(wsHandler))
+	goauction.POST("/socket.io/*any", gin.WrapH(wsHandler))
+	goauction.Handle("WS", "/socket.io/*any", gin.WrapH(wsHandler))
+	goauction.Handle("WSS", "/socket.io/*any", gin.WrapH(wsHandler))
 	// goauction.Run(docs.SwaggerInfo.Host)
 
 	srv := &http.Server{
@@ -79,7 +97,44 @@ func main() {
 		WriteTimeout: 15 * time.Second,
 		ReadTimeout:  15 * time.Second,
 	}
+	// stream SSE ditutup sebelum WriteTimeout, client melanjutkan dengan Last-Event-ID
+	socket.StreamTimeout = srv.WriteTimeout
+
+	go func() {
+		fmt.Println("\nListening on", docs.SwaggerInfo.Host)
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatal(err)
+		}
+	}()
+
+	<-ctx.Done()
+	shutdown(srv, QueueDispatcher, wsHandler)
+}
+
+// shutdown menghentikan semua komponen secara berurutan dalam batas shutdownTimeout
+func shutdown(srv *http.Server, dispatcher *queue.Dispatcher, wsHandler *socketio.Server) {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	// stop accepting new connections and wait for in-flight requests
+	if err := srv.Shutdown(ctx); err != nil {
+		log.Printf("Main] http server shutdown error: %s\n", err.Error())
+	}
+
+	// monitors are stopped before the dispatcher so they can't produce new work
+	if err := monitor.StopMonitors(ctx); err != nil {
+		log.Printf("Main] monitor shutdown error: %s\n", err.Error())
+	}
+
+	if err := dispatcher.Stop(ctx); err != nil {
+		log.Printf("Main] queue shutdown error: %s\n", err.Error())
+	}
+
+	if err := wsHandler.Close(); err != nil {
+		log.Printf("Main] socket shutdown error: %s\n", err.Error())
+	}
+	socket.StopCluster()
 
-	fmt.Println("\nListening on", docs.SwaggerInfo.Host)
-	log.Fatal(srv.ListenAndServe())
+	app.CloseDatabase()
+	log.Println("Main] bye")
 }
@@ -0,0 +1,29 @@
+
+-- +migrate Up
+CREATE TABLE jobs (
+  id BIGSERIAL PRIMARY KEY,
+  job_type VARCHAR NOT NULL, -- nama type yang didaftarkan lewat queue.Register
+  payload TEXT NOT NULL, -- job dalam bentuk json
+  attempts INT NOT NULL DEFAULT 0,
+  max_attempts INT NOT NULL DEFAULT 5,
+  last_error TEXT NOT NULL DEFAULT '',
+  run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  locked_at TIMESTAMP, -- diisi ketika job sedang dikerjakan oleh worker
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX jobs_run_at ON jobs (run_at);
+
+CREATE TABLE dead_jobs (
+  id BIGINT PRIMARY KEY, -- id asal dari tabel jobs
+  job_type VARCHAR NOT NULL,
+  payload TEXT NOT NULL,
+  attempts INT NOT NULL,
+  last_error TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL,
+  failed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +migrate Down
+DROP INDEX IF EXISTS jobs_run_at;
+DROP TABLE IF EXISTS dead_jobs;
+DROP TABLE IF EXISTS jobs;
@@ -0,0 +1,18 @@
+
+-- +migrate Up
+ALTER TABLE jobs ADD COLUMN unique_key VARCHAR; -- job dengan key yang sama akan menggantikan job yang belum berjalan
+CREATE UNIQUE INDEX jobs_unique_key ON jobs (unique_key);
+
+CREATE TABLE job_schedules (
+  name VARCHAR PRIMARY KEY,
+  spec VARCHAR NOT NULL, -- cron spec, eg: "0 7 * * *"
+  job_type VARCHAR NOT NULL,
+  payload TEXT NOT NULL,
+  next_run_at TIMESTAMP NOT NULL,
+  last_run_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +migrate Down
+DROP TABLE IF EXISTS job_schedules;
+DROP INDEX IF EXISTS jobs_unique_key;
+ALTER TABLE jobs DROP COLUMN IF EXISTS unique_key;
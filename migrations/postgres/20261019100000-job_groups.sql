@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE jobs ADD COLUMN group_key VARCHAR; -- job dengan group yang sama dijalankan berurutan
+CREATE INDEX jobs_group_key ON jobs (group_key, id);
+-- +migrate Down
+DROP INDEX IF EXISTS jobs_group_key;
+ALTER TABLE jobs DROP COLUMN IF EXISTS group_key;
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE outbox_events (
+  id BIGSERIAL PRIMARY KEY, -- digunakan sebagai event id oleh consumer untuk dedupe
+  event_type VARCHAR NOT NULL,
+  payload TEXT NOT NULL,
+  aggregate_id VARCHAR,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  published_at TIMESTAMP
+);
+CREATE INDEX outbox_events_unpublished ON outbox_events (id) WHERE published_at IS NULL;
+
+CREATE TABLE processed_events (
+  event_id BIGINT NOT NULL,
+  subscriber VARCHAR NOT NULL,
+  processed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (event_id, subscriber)
+);
+-- +migrate Down
+DROP TABLE IF EXISTS processed_events;
+DROP TABLE IF EXISTS outbox_events;
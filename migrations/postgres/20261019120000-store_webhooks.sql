@@ -0,0 +1,41 @@
+-- +migrate Up
+CREATE TABLE store_webhooks (
+  id BIGSERIAL PRIMARY KEY,
+  store_id BIGINT NOT NULL REFERENCES stores (id) ON DELETE CASCADE,
+  url VARCHAR NOT NULL,
+  secret VARCHAR NOT NULL,
+  event_types VARCHAR NOT NULL, -- dipisah koma, eg: "bid.created,auction.closed"
+  active BOOLEAN NOT NULL DEFAULT TRUE,
+  failure_count INT NOT NULL DEFAULT 0, -- jumlah delivery gagal berturut-turut
+  disabled_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX store_webhooks_store_id ON store_webhooks (store_id);
+
+CREATE TABLE webhook_deliveries (
+  id BIGSERIAL PRIMARY KEY,
+  webhook_id BIGINT NOT NULL REFERENCES store_webhooks (id) ON DELETE CASCADE,
+  event_type VARCHAR NOT NULL,
+  payload TEXT NOT NULL,
+  status SMALLINT NOT NULL DEFAULT 0, -- 0: pending, 1: success, 2: failed
+  attempts INT NOT NULL DEFAULT 0,
+  last_status_code INT NOT NULL DEFAULT 0,
+  last_error TEXT NOT NULL DEFAULT '',
+  delivered_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX webhook_deliveries_webhook_id ON webhook_deliveries (webhook_id, id);
+
+CREATE TABLE webhook_delivery_attempts (
+  id BIGSERIAL PRIMARY KEY,
+  delivery_id BIGINT NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+  status_code INT NOT NULL DEFAULT 0,
+  error TEXT NOT NULL DEFAULT '',
+  duration_ms BIGINT NOT NULL DEFAULT 0,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX webhook_delivery_attempts_delivery_id ON webhook_delivery_attempts (delivery_id);
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS store_webhooks;
@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE notif_preferences (
+  user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  notif_type SMALLINT NOT NULL,
+  channels VARCHAR NOT NULL, -- dipisah koma, eg: "push,socket", kosong berarti tidak menerima notif
+  PRIMARY KEY (user_id, notif_type)
+);
+
+CREATE TABLE notif_settings (
+  user_id BIGINT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+  quiet_start VARCHAR NOT NULL DEFAULT '', -- format HH:MM, kosong berarti quiet hours tidak aktif
+  quiet_end VARCHAR NOT NULL DEFAULT '',
+  timezone VARCHAR NOT NULL DEFAULT 'Asia/Jakarta'
+);
+-- +migrate Down
+DROP TABLE IF EXISTS notif_settings;
+DROP TABLE IF EXISTS notif_preferences;
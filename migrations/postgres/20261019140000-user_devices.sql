@@ -0,0 +1,22 @@
+-- +migrate Up
+-- satu baris per device token, user boleh punya lebih dari satu device
+ALTER TABLE user_connects DROP CONSTRAINT IF EXISTS user_connects_pkey;
+ALTER TABLE user_connects ADD COLUMN id BIGSERIAL PRIMARY KEY;
+ALTER TABLE user_connects ADD COLUMN app_version VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE user_connects ADD COLUMN last_seen_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc');
+-- flow lama mengizinkan app_id yang sama terdaftar di beberapa user, sisakan yang terbaru.
+-- baris lama belum punya waktu sehingga urutan diambil dari id yang baru diisi sesuai urutan baris
+DELETE FROM user_connects a USING user_connects b
+  WHERE a.app_id = b.app_id AND a.id < b.id;
+ALTER TABLE user_connects ADD CONSTRAINT user_connects_app_id_key UNIQUE (app_id);
+CREATE INDEX user_connects_user_id ON user_connects (user_id);
+-- +migrate Down
+DROP INDEX IF EXISTS user_connects_user_id;
+ALTER TABLE user_connects DROP CONSTRAINT IF EXISTS user_connects_app_id_key;
+-- hanya device terakhir yang dipertahankan
+DELETE FROM user_connects a USING user_connects b
+  WHERE a.user_id = b.user_id AND (a.last_seen_at, a.id) < (b.last_seen_at, b.id);
+ALTER TABLE user_connects DROP COLUMN last_seen_at;
+ALTER TABLE user_connects DROP COLUMN app_version;
+ALTER TABLE user_connects DROP COLUMN id;
+ALTER TABLE user_connects ADD PRIMARY KEY (user_id);
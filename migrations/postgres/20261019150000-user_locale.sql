@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE users ADD COLUMN locale VARCHAR(5) NOT NULL DEFAULT 'id'; -- eg: id, en
+-- judul notif hasil template bisa lebih panjang dari 60 karakter
+ALTER TABLE user_notifs ALTER COLUMN title TYPE VARCHAR(255);
+-- +migrate Down
+ALTER TABLE user_notifs ALTER COLUMN title TYPE VARCHAR(60) USING left(title, 60);
+ALTER TABLE users DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,11 @@
+-- +migrate Up
+-- list notif diurutkan dari id terbaru, unread count hanya membaca notif yang belum dibaca
+DROP INDEX IF EXISTS user_notifs_user_id;
+CREATE INDEX user_notifs_user_id ON user_notifs (user_id, id DESC);
+CREATE INDEX user_notifs_unread ON user_notifs (user_id) WHERE NOT "read";
+CREATE INDEX user_notifs_read_created_at ON user_notifs (created_at) WHERE "read";
+-- +migrate Down
+DROP INDEX IF EXISTS user_notifs_read_created_at;
+DROP INDEX IF EXISTS user_notifs_unread;
+DROP INDEX IF EXISTS user_notifs_user_id;
+CREATE INDEX user_notifs_user_id ON user_notifs (user_id);
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- notif yang menunggu digabung dengan notif sejenis untuk penerima dan target yang sama
+CREATE TABLE notif_buffers (
+  id BIGSERIAL PRIMARY KEY,
+  user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  notif_type SMALLINT NOT NULL,
+  target_id BIGINT NOT NULL,
+  data TEXT NOT NULL, -- json, parameter template notif
+  item TEXT NOT NULL, -- json, item yang dikirim bersama notif
+  created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+CREATE INDEX notif_buffers_target ON notif_buffers (user_id, notif_type, target_id);
+
+ALTER TABLE notif_settings ADD COLUMN daily_digest BOOLEAN NOT NULL DEFAULT 'f';
+CREATE INDEX notif_settings_daily_digest ON notif_settings (user_id) WHERE daily_digest;
+-- +migrate Down
+DROP INDEX IF EXISTS notif_settings_daily_digest;
+ALTER TABLE notif_settings DROP COLUMN IF EXISTS daily_digest;
+DROP TABLE IF EXISTS notif_buffers;
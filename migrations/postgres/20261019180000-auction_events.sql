@@ -0,0 +1,12 @@
+-- +migrate Up
+-- event lelang per product untuk live stream, id digunakan client untuk resume setelah reconnect
+CREATE TABLE auction_events (
+  id BIGSERIAL PRIMARY KEY,
+  product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  event_type VARCHAR(50) NOT NULL, -- eg: bid_placed, time_extended, auction_closed, winner
+  data TEXT NOT NULL, -- json
+  created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'utc')
+);
+CREATE INDEX auction_events_product_id ON auction_events (product_id, id);
+-- +migrate Down
+DROP TABLE IF EXISTS auction_events;
@@ -0,0 +1,15 @@
+-- +migrate Up
+-- read pointer per participant: semua message dengan id <= last_read_id sudah dibaca
+CREATE TABLE chat_reads (
+  chat_id BIGINT NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+  user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  last_read_id BIGINT NOT NULL DEFAULT 0,
+  read_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (chat_id, user_id)
+);
+CREATE INDEX idx_messages_chat_receiver ON messages (chat_id, receiver_id, id);
+ALTER TABLE users ADD COLUMN last_seen_at TIMESTAMP;
+-- +migrate Down
+ALTER TABLE users DROP COLUMN IF EXISTS last_seen_at;
+DROP INDEX IF EXISTS idx_messages_chat_receiver;
+DROP TABLE IF EXISTS chat_reads;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- instance socket server yang sedang berjalan, node tanpa heartbeat dianggap mati
+CREATE TABLE socket_nodes (
+  node_id VARCHAR(100) PRIMARY KEY,
+  heartbeat_at TIMESTAMP NOT NULL
+);
+-- jumlah koneksi socket user per node
+CREATE TABLE socket_presence (
+  node_id VARCHAR(100) NOT NULL REFERENCES socket_nodes (node_id) ON DELETE CASCADE,
+  user_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  connections INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (node_id, user_id)
+);
+CREATE INDEX idx_socket_presence_user_id ON socket_presence (user_id);
+-- payload broadcast yang melebihi batas ukuran NOTIFY
+CREATE TABLE socket_broadcasts (
+  id BIGSERIAL PRIMARY KEY,
+  payload TEXT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +migrate Down
+DROP TABLE IF EXISTS socket_broadcasts;
+DROP TABLE IF EXISTS socket_presence;
+DROP TABLE IF EXISTS socket_nodes;
@@ -0,0 +1,51 @@
+-- +migrate Up
+-- room dengan diri sendiri tidak valid
+DELETE FROM chats WHERE initiator_id = subscriber_id;
+
+-- gabungkan room duplikat untuk pasangan user yang sama ke room tertua
+CREATE TEMPORARY TABLE chat_merges AS
+SELECT id, keep_id FROM (
+  SELECT id, MIN(id) OVER (PARTITION BY LEAST(initiator_id, subscriber_id), GREATEST(initiator_id, subscriber_id)) AS keep_id
+  FROM chats
+) ranked WHERE id <> keep_id;
+
+UPDATE messages m SET chat_id = cm.keep_id FROM chat_merges cm WHERE m.chat_id = cm.id;
+UPDATE user_chat_histories h SET chat_id = cm.keep_id FROM chat_merges cm WHERE h.chat_id = cm.id;
+
+INSERT INTO chat_reads (chat_id, user_id, last_read_id, read_at)
+SELECT cm.keep_id, r.user_id, MAX(r.last_read_id), MAX(r.read_at)
+FROM chat_reads r JOIN chat_merges cm ON cm.id = r.chat_id
+GROUP BY cm.keep_id, r.user_id
+ON CONFLICT (chat_id, user_id) DO UPDATE
+SET last_read_id = GREATEST(chat_reads.last_read_id, EXCLUDED.last_read_id),
+  read_at = GREATEST(chat_reads.read_at, EXCLUDED.read_at);
+
+UPDATE chats c SET last_updated = merged.last_updated
+FROM (
+  SELECT cm.keep_id, MAX(d.last_updated) AS last_updated
+  FROM chat_merges cm JOIN chats d ON d.id = cm.id
+  GROUP BY cm.keep_id
+) merged
+WHERE c.id = merged.keep_id AND c.last_updated < merged.last_updated;
+
+DELETE FROM chats WHERE id IN (SELECT id FROM chat_merges);
+DROP TABLE chat_merges;
+
+CREATE UNIQUE INDEX idx_chats_pair ON chats (LEAST(initiator_id, subscriber_id), GREATEST(initiator_id, subscriber_id));
+ALTER TABLE chats ADD CONSTRAINT chats_distinct_users CHECK (initiator_id <> subscriber_id);
+
+-- product yang terakhir dibicarakan pada room
+ALTER TABLE chats ADD COLUMN product_id BIGINT REFERENCES products (id) ON DELETE SET NULL;
+-- semua product yang pernah dibicarakan pada room, untuk listing room per product
+CREATE TABLE chat_products (
+  chat_id BIGINT NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+  product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  ts TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (chat_id, product_id)
+);
+CREATE INDEX idx_chat_products_product_id ON chat_products (product_id, ts);
+-- +migrate Down
+DROP TABLE IF EXISTS chat_products;
+ALTER TABLE chats DROP COLUMN IF EXISTS product_id;
+ALTER TABLE chats DROP CONSTRAINT IF EXISTS chats_distinct_users;
+DROP INDEX IF EXISTS idx_chats_pair;
@@ -0,0 +1,6 @@
+-- +migrate Up
+ALTER TABLE messages ADD COLUMN edited_at TIMESTAMP;
+CREATE INDEX idx_user_chat_histories_owner_message ON user_chat_histories (owner_id, message_id);
+-- +migrate Down
+DROP INDEX IF EXISTS idx_user_chat_histories_owner_message;
+ALTER TABLE messages DROP COLUMN IF EXISTS edited_at;
@@ -0,0 +1,19 @@
+-- +migrate Up
+CREATE TABLE chat_attachments (
+  id BIGSERIAL PRIMARY KEY,
+  chat_id BIGINT NOT NULL REFERENCES chats (id) ON DELETE CASCADE,
+  uploader_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  storage_key VARCHAR(255) NOT NULL UNIQUE,
+  file_name VARCHAR(255) NOT NULL,
+  content_type VARCHAR(100) NOT NULL,
+  kind SMALLINT NOT NULL,
+  size BIGINT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX idx_chat_attachments_chat_id ON chat_attachments (chat_id);
+ALTER TABLE messages ADD COLUMN attachment_id BIGINT REFERENCES chat_attachments (id) ON DELETE SET NULL;
+ALTER TABLE messages ALTER COLUMN attachment_data SET DEFAULT '';
+-- +migrate Down
+ALTER TABLE messages ALTER COLUMN attachment_data DROP DEFAULT;
+ALTER TABLE messages DROP COLUMN IF EXISTS attachment_id;
+DROP TABLE IF EXISTS chat_attachments;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- blocker_id memblokir blocked_id: blocked tidak bisa chat, bid product blocker, maupun mengirim notif ke blocker
+CREATE TABLE user_blocks (
+  blocker_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  blocked_id BIGINT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (blocker_id, blocked_id),
+  CHECK (blocker_id <> blocked_id)
+);
+CREATE INDEX idx_user_blocks_blocked_id ON user_blocks (blocked_id);
+-- +migrate Down
+DROP TABLE IF EXISTS user_blocks;
@@ -0,0 +1,24 @@
+-- +migrate Up
+-- config full-text chat: stemmer indonesian jika tersedia (postgres 12+), selain itu simple
+-- +migrate StatementBegin
+DO $$
+BEGIN
+  IF EXISTS (SELECT 1 FROM pg_ts_config WHERE cfgname = 'indonesian') THEN
+    CREATE TEXT SEARCH CONFIGURATION public.chat_search (COPY = pg_catalog.indonesian);
+  ELSE
+    CREATE TEXT SEARCH CONFIGURATION public.chat_search (COPY = pg_catalog.simple);
+  END IF;
+END
+$$;
+-- +migrate StatementEnd
+ALTER TABLE messages ADD COLUMN search_vector TSVECTOR;
+UPDATE messages SET search_vector = to_tsvector('public.chat_search', "text");
+CREATE INDEX idx_messages_search_vector ON messages USING GIN (search_vector);
+-- search_vector selalu mengikuti text, termasuk ketika message diedit atau dihapus
+CREATE TRIGGER messages_search_vector_update BEFORE INSERT OR UPDATE OF "text" ON messages
+  FOR EACH ROW EXECUTE PROCEDURE tsvector_update_trigger(search_vector, 'public.chat_search', "text");
+-- +migrate Down
+DROP TRIGGER IF EXISTS messages_search_vector_update ON messages;
+DROP INDEX IF EXISTS idx_messages_search_vector;
+ALTER TABLE messages DROP COLUMN IF EXISTS search_vector;
+DROP TEXT SEARCH CONFIGURATION IF EXISTS public.chat_search;
@@ -0,0 +1,31 @@
+-- +migrate Up
+-- gambar product diupload lebih dulu (product_id kosong) lalu dipasang ke product saat add/update
+ALTER TABLE product_images ALTER COLUMN product_id DROP NOT NULL;
+ALTER TABLE product_images ADD COLUMN uploader_id BIGINT REFERENCES users (id) ON DELETE CASCADE;
+ALTER TABLE product_images ADD COLUMN storage_key VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE product_images ADD COLUMN thumb_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE product_images ADD COLUMN medium_url TEXT NOT NULL DEFAULT '';
+ALTER TABLE product_images ADD COLUMN width INT NOT NULL DEFAULT 0;
+ALTER TABLE product_images ADD COLUMN height INT NOT NULL DEFAULT 0;
+ALTER TABLE product_images ADD COLUMN position INT NOT NULL DEFAULT 0;
+ALTER TABLE product_images ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE product_images ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- gambar lama berupa url eksternal tanpa variant, urutan mengikuti id
+UPDATE product_images SET thumb_url = image_url, medium_url = image_url;
+UPDATE product_images pi SET position = o.position, is_primary = o.position = 0
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY product_id ORDER BY id) - 1 AS position FROM product_images) o
+WHERE pi.id = o.id;
+CREATE INDEX idx_product_images_pending ON product_images (uploader_id) WHERE product_id IS NULL;
+-- +migrate Down
+DROP INDEX IF EXISTS idx_product_images_pending;
+DELETE FROM product_images WHERE product_id IS NULL;
+ALTER TABLE product_images DROP COLUMN IF EXISTS created_at;
+ALTER TABLE product_images DROP COLUMN IF EXISTS is_primary;
+ALTER TABLE product_images DROP COLUMN IF EXISTS position;
+ALTER TABLE product_images DROP COLUMN IF EXISTS height;
+ALTER TABLE product_images DROP COLUMN IF EXISTS width;
+ALTER TABLE product_images DROP COLUMN IF EXISTS medium_url;
+ALTER TABLE product_images DROP COLUMN IF EXISTS thumb_url;
+ALTER TABLE product_images DROP COLUMN IF EXISTS storage_key;
+ALTER TABLE product_images DROP COLUMN IF EXISTS uploader_id;
+ALTER TABLE product_images ALTER COLUMN product_id SET NOT NULL;
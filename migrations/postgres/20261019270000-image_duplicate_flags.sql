@@ -0,0 +1,21 @@
+-- +migrate Up
+-- perceptual hash (dhash 64 bit) gambar upload, gambar lama berupa url eksternal tidak punya hash
+ALTER TABLE product_images ADD COLUMN phash BIGINT;
+-- gambar product yang mirip gambar product store lain, badge peringatan tampil selama resolved_at kosong.
+-- flag yang sudah diputuskan tetap disimpan agar pasangan gambar yang sama tidak diflag ulang
+CREATE TABLE image_duplicate_flags (
+  id BIGSERIAL PRIMARY KEY,
+  product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  image_id BIGINT NOT NULL REFERENCES product_images (id) ON DELETE CASCADE,
+  matched_product_id BIGINT NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+  matched_image_id BIGINT NOT NULL REFERENCES product_images (id) ON DELETE CASCADE,
+  distance INT NOT NULL,
+  resolved_by BIGINT REFERENCES users (id) ON DELETE SET NULL,
+  resolved_at TIMESTAMP,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  UNIQUE (image_id, matched_image_id)
+);
+CREATE INDEX idx_image_duplicate_flags_pending ON image_duplicate_flags (product_id) WHERE resolved_at IS NULL;
+-- +migrate Down
+DROP TABLE IF EXISTS image_duplicate_flags;
+ALTER TABLE product_images DROP COLUMN IF EXISTS phash;
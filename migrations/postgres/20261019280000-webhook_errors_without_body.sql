@@ -0,0 +1,7 @@
+-- +migrate Up
+-- error delivery dulu menyimpan potongan response body receiver, sisakan status code saja
+UPDATE webhook_deliveries SET last_error = SUBSTRING(last_error FROM '^receiver responded [0-9]+')
+WHERE last_error LIKE 'receiver responded %';
+UPDATE webhook_delivery_attempts SET error = SUBSTRING(error FROM '^receiver responded [0-9]+')
+WHERE error LIKE 'receiver responded %';
+-- +migrate Down
@@ -0,0 +1,21 @@
+-- +migrate Up
+-- phash dibagi menjadi 7 band (10 + 6x9 bit), dua hash dengan jarak hamming maksimal 6 pasti punya
+-- minimal satu band yang sama sehingga pencarian gambar duplikat cukup membandingkan gambar
+-- yang band-nya beririsan melalui index. Nilai band diberi offset 1024 per band agar tidak tertukar
+-- +migrate StatementBegin
+CREATE FUNCTION phash_bands(hash BIGINT) RETURNS INT[] AS $$
+  SELECT ARRAY[
+    ((hash >> 54) & 1023)::INT,
+    1024 + ((hash >> 45) & 511)::INT,
+    2048 + ((hash >> 36) & 511)::INT,
+    3072 + ((hash >> 27) & 511)::INT,
+    4096 + ((hash >> 18) & 511)::INT,
+    5120 + ((hash >> 9) & 511)::INT,
+    6144 + (hash & 511)::INT
+  ]
+$$ LANGUAGE SQL IMMUTABLE;
+-- +migrate StatementEnd
+CREATE INDEX idx_product_images_phash_bands ON product_images USING GIN (phash_bands(phash)) WHERE phash IS NOT NULL;
+-- +migrate Down
+DROP INDEX IF EXISTS idx_product_images_phash_bands;
+DROP FUNCTION IF EXISTS phash_bands(BIGINT);
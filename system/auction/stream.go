@@ -0,0 +1,156 @@
+package auction
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/event"
+)
+
+// Jenis event pada live stream lelang
+const (
+	EventBidPlaced     = "bid_placed"
+	EventTimeExtended  = "time_extended"
+	EventAuctionClosed = "auction_closed"
+	EventWinner        = "winner"
+)
+
+// MaxReplay batas event yang dikirim ulang ketika client resume
+const MaxReplay = 500
+
+// Message event lelang yang dikirim ke client,
+// client menyimpan ID terakhir untuk resume setelah reconnect
+type Message struct {
+	ID        int64           `json:"id"`
+	ProductID int64           `json:"product_id"`
+	Type      string          `json:"type"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAT *time.Time      `json:"created_at"`
+}
+
+// Broadcaster mengirim message ke semua subscriber product, didaftarkan oleh socket server
+type Broadcaster func(msg *Message)
+
+var (
+	broadcasterMu sync.RWMutex
+	broadcaster   Broadcaster
+)
+
+func init() {
+	event.DefaultBus.Subscribe(&event.UserBidProductEvent{}, "auction.user_bid_product", onUserBidProduct)
+	event.DefaultBus.Subscribe(&event.AuctionExtendedEvent{}, "auction.auction_extended", onAuctionExtended)
+	event.DefaultBus.Subscribe(&event.ProductClosedEvent{}, "auction.product_closed", onProductClosed)
+}
+
+// SetBroadcaster daftarkan broadcaster, broadcaster sebelumnya akan diganti
+func SetBroadcaster(b Broadcaster) {
+	broadcasterMu.Lock()
+	defer broadcasterMu.Unlock()
+	broadcaster = b
+}
+
+// Entry satu event lelang yang akan dipublish
+type Entry struct {
+	Type string
+	Data interface{}
+}
+
+// Publish simpan event lelang dalam satu transaksi lalu kirim ke subscriber product sesuai urutan
+func Publish(productID int64, entries ...Entry) error {
+	rows := []models.AuctionEvent{}
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry.Data)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, models.AuctionEvent{ProductID: productID, EventType: entry.Type, Data: string(raw)})
+	}
+
+	if err := repository.NewAuctionRepository().CreateAuctionEvents(rows); err != nil {
+		return err
+	}
+
+	broadcasterMu.RLock()
+	b := broadcaster
+	broadcasterMu.RUnlock()
+	if b != nil {
+		for _, row := range rows {
+			b(toMessage(row))
+		}
+	}
+	return nil
+}
+
+// Replay event lelang product setelah lastEventID, digunakan client yang resume
+func Replay(productID int64, lastEventID int64) ([]*Message, error) {
+	rows, err := repository.NewAuctionRepository().GetAuctionEventsAfter(productID, lastEventID, MaxReplay)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []*Message{}
+	for _, row := range rows {
+		messages = append(messages, toMessage(row))
+	}
+	return messages, nil
+}
+
+// ReplayProducts event lelang beberapa product setelah lastEventID, paling banyak limit event
+func ReplayProducts(productIDs []int64, lastEventID int64, limit int) ([]*Message, error) {
+	rows, err := repository.NewAuctionRepository().GetProductsEventsAfter(productIDs, lastEventID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := []*Message{}
+	for _, row := range rows {
+		messages = append(messages, toMessage(row))
+	}
+	return messages, nil
+}
+
+func toMessage(row models.AuctionEvent) *Message {
+	return &Message{
+		ID:        row.ID,
+		ProductID: row.ProductID,
+		Type:      row.EventType,
+		Data:      json.RawMessage(row.Data),
+		CreatedAT: row.CreatedAT,
+	}
+}
+
+func onUserBidProduct(ev interface{}) error {
+	e := ev.(*event.UserBidProductEvent)
+	return Publish(e.Product.ID, Entry{EventBidPlaced, map[string]interface{}{
+		"bid_id":     e.BidData.ID,
+		"user":       repository.NewUserRepository().UserSimple(e.BidData.UserID),
+		"bid_price":  e.BidData.BidPrice,
+		"bid_status": e.Product.GetBidderStatus(nil),
+	}})
+}
+
+func onAuctionExtended(ev interface{}) error {
+	e := ev.(*event.AuctionExtendedEvent)
+	return Publish(e.Product.ID, Entry{EventTimeExtended, map[string]interface{}{
+		"closed_at": e.Product.ClosedAT,
+	}})
+}
+
+// onProductClosed closed dan winner disimpan bersamaan agar retry tidak menduplikasi event
+func onProductClosed(ev interface{}) error {
+	e := ev.(*event.ProductClosedEvent)
+	entries := []Entry{{EventAuctionClosed, map[string]interface{}{
+		"closed_at": e.Product.ClosedAT,
+	}}}
+	if e.WinnerID != nil {
+		entries = append(entries, Entry{EventWinner, map[string]interface{}{
+			"winner":        repository.NewUserRepository().UserSimple(*e.WinnerID),
+			"winning_price": e.WinningPrice,
+		}})
+	}
+
+	return Publish(e.Product.ID, entries...)
+}
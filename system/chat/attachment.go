@@ -0,0 +1,188 @@
+package chat
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/storage"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// AttachmentNone message tanpa attachment
+	AttachmentNone = iota
+	// AttachmentImage attachment berupa gambar
+	AttachmentImage
+	// AttachmentDocument attachment berupa dokumen pdf
+	AttachmentDocument
+)
+
+// MaxAttachmentSize ukuran maksimal file attachment
+const MaxAttachmentSize = 10 << 20
+
+// AttachmentURLTTL masa berlaku url download attachment
+var AttachmentURLTTL = time.Hour
+
+// attachmentTypes content type yang diizinkan beserta kind dan ekstensinya,
+// content type dideteksi dari isi file, bukan dari header upload
+var attachmentTypes = map[string]struct {
+	kind int
+	ext  string
+}{
+	"image/jpeg":      {AttachmentImage, ".jpg"},
+	"image/png":       {AttachmentImage, ".png"},
+	"image/gif":       {AttachmentImage, ".gif"},
+	"image/webp":      {AttachmentImage, ".webp"},
+	"application/pdf": {AttachmentDocument, ".pdf"},
+}
+
+var (
+	// ErrAttachmentTooLarge ukuran file melebihi MaxAttachmentSize
+	ErrAttachmentTooLarge = fmt.Errorf("Ukuran file maksimal %d MB", MaxAttachmentSize>>20)
+	// ErrAttachmentType jenis file tidak didukung
+	ErrAttachmentType = errors.New("Jenis file tidak didukung, gunakan gambar (jpeg, png, gif, webp) atau pdf")
+	// ErrAttachmentNotFound attachment tidak ditemukan
+	ErrAttachmentNotFound = errors.New("Attachment tidak ditemukan")
+	// ErrInvalidSignature url download tidak valid atau sudah kadaluarsa
+	ErrInvalidSignature = errors.New("Url tidak valid atau sudah kadaluarsa")
+)
+
+// AttachmentLink data attachment beserta url download yang sudah ditandatangani
+type AttachmentLink struct {
+	ID          int64     `json:"id"`
+	Kind        int       `json:"kind"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	URL         string    `json:"url"`
+	ExpiresAT   time.Time `json:"expires_at"`
+}
+
+// Upload validasi lalu simpan file ke storage sebagai attachment chat room,
+// hanya participant yang bisa upload. Attachment dikirim dengan Send melalui attachment_id
+func Upload(uploaderID int64, chatID int64, fileName string, file io.Reader, size int64) (models.ChatAttachment, error) {
+	chatRepo := repository.NewChatRepository()
+	if !chatRepo.IsChatParticipant(chatID, uploaderID) {
+		return models.ChatAttachment{}, ErrNotParticipant
+	}
+	if size <= 0 || size > MaxAttachmentSize {
+		return models.ChatAttachment{}, ErrAttachmentTooLarge
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return models.ChatAttachment{}, err
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	fileType, ok := attachmentTypes[contentType]
+	if !ok {
+		return models.ChatAttachment{}, ErrAttachmentType
+	}
+
+	key := fmt.Sprintf("chat/%d/%s%s", chatID, storage.RandomName(), fileType.ext)
+	body := io.LimitReader(io.MultiReader(bytes.NewReader(head), file), MaxAttachmentSize)
+	if err := storage.Default().Put(key, body, size, contentType); err != nil {
+		return models.ChatAttachment{}, err
+	}
+
+	attachment, err := chatRepo.CreateAttachment(models.ChatAttachment{
+		ChatID:      chatID,
+		UploaderID:  uploaderID,
+		StorageKey:  key,
+		FileName:    filepath.Base(fileName),
+		ContentType: contentType,
+		Kind:        fileType.kind,
+		Size:        size,
+	})
+	if err != nil {
+		storage.Default().Delete(key)
+		return models.ChatAttachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// ResolveAttachment url download attachment, hanya untuk participant chat room
+func ResolveAttachment(userID int64, attachmentID int64) (*AttachmentLink, error) {
+	chatRepo := repository.NewChatRepository()
+	attachment, err := chatRepo.GetAttachmentByID(attachmentID)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrAttachmentNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !chatRepo.IsChatParticipant(attachment.ChatID, userID) {
+		return nil, ErrNotParticipant
+	}
+
+	return toLink(attachment), nil
+}
+
+// OpenAttachment buka file attachment dari url download yang sudah ditandatangani
+func OpenAttachment(attachmentID int64, expires int64, signature string) (models.ChatAttachment, io.ReadCloser, error) {
+	if !storage.Verify(attachmentResource(attachmentID), expires, signature) {
+		return models.ChatAttachment{}, nil, ErrInvalidSignature
+	}
+
+	attachment, err := repository.NewChatRepository().GetAttachmentByID(attachmentID)
+	if gorm.IsRecordNotFoundError(err) {
+		return attachment, nil, ErrAttachmentNotFound
+	} else if err != nil {
+		return attachment, nil, err
+	}
+
+	file, err := storage.Default().Get(attachment.StorageKey)
+	if err == storage.ErrObjectNotFound {
+		return attachment, nil, ErrAttachmentNotFound
+	}
+	return attachment, file, err
+}
+
+// removeAttachment hapus attachment beserta file-nya di storage
+func removeAttachment(attachmentID int64) {
+	chatRepo := repository.NewChatRepository()
+	attachment, err := chatRepo.GetAttachmentByID(attachmentID)
+	if err != nil {
+		return
+	}
+
+	if err := chatRepo.DeleteAttachment(attachment.ID); err != nil {
+		log.Printf("Chat] Delete attachment #%d error: %s\n", attachment.ID, err.Error())
+		return
+	}
+	if err := storage.Default().Delete(attachment.StorageKey); err != nil {
+		log.Printf("Chat] Delete object %s error: %s\n", attachment.StorageKey, err.Error())
+	}
+}
+
+func toLink(attachment models.ChatAttachment) *AttachmentLink {
+	expires := time.Now().Add(AttachmentURLTTL)
+	signature := storage.Sign(attachmentResource(attachment.ID), expires)
+
+	return &AttachmentLink{
+		ID:          attachment.ID,
+		Kind:        attachment.Kind,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		URL: fmt.Sprintf("/api/attachment/v1/download?id=%d&expires=%d&sig=%s",
+			attachment.ID, expires.Unix(), signature),
+		ExpiresAT: expires.UTC(),
+	}
+}
+
+func attachmentResource(attachmentID int64) string {
+	return "chat-attachment:" + strconv.FormatInt(attachmentID, 10)
+}
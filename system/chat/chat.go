@@ -0,0 +1,291 @@
+package chat
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/jinzhu/gorm"
+)
+
+var (
+	// ErrChatNotFound chat room tidak ditemukan
+	ErrChatNotFound = errors.New("Chat tidak ditemukan")
+	// ErrNotParticipant user bukan participant chat room
+	ErrNotParticipant = errors.New("Anda tidak memiliki akses ke chat ini")
+	// ErrEmptyMessage message tanpa text dan attachment
+	ErrEmptyMessage = errors.New("Pesan tidak boleh kosong")
+	// ErrMessageNotFound message tidak ditemukan atau sudah dihapus dari history user
+	ErrMessageNotFound = errors.New("Pesan tidak ditemukan")
+	// ErrNotSender hanya pengirim yang bisa mengubah atau menghapus message untuk semua participant
+	ErrNotSender = errors.New("Anda bukan pengirim pesan ini")
+	// ErrWindowExpired batas waktu edit atau hapus message sudah lewat
+	ErrWindowExpired = errors.New("Batas waktu untuk mengubah pesan sudah lewat")
+	// ErrBlocked salah satu participant memblokir yang lain
+	ErrBlocked = errors.New("Anda tidak dapat mengirim pesan ke user ini")
+)
+
+var (
+	// EditWindow batas waktu edit message sejak dikirim
+	EditWindow = 15 * time.Minute
+	// DeleteWindow batas waktu hapus message untuk semua participant sejak dikirim
+	DeleteWindow = time.Hour
+)
+
+// Reply message yang dikirim ke participant chat room
+type Reply struct {
+	ID             int64              `json:"id"`
+	ChatID         int64              `json:"chat_id"`
+	Sender         *models.UserSimple `json:"sender"`
+	Receiver       *models.UserSimple `json:"receiver"`
+	Text           string             `json:"text"`
+	AttachmentKind int                `json:"attachment_kind"`
+	Attachment     *AttachmentLink    `json:"attachment"`
+	Deleted        bool               `json:"deleted"`
+	TS             *time.Time         `json:"ts"`
+	EditedAT       *time.Time         `json:"edited_at"`
+}
+
+// Transport pengiriman live ke participant, didaftarkan oleh socket server
+type Transport interface {
+	// Push kirim reply ke room chat dan ke semua koneksi penerima,
+	// penerima yang Online pasti menerima reply walaupun belum join ke room chat
+	Push(reply *Reply)
+	// PushUpdate kirim message yang diedit atau dihapus ke room chat
+	PushUpdate(reply *Reply)
+	// PushRead kirim read receipt ke room chat
+	PushRead(read models.ChatRead)
+	// Online cek apakah user punya koneksi socket aktif
+	Online(userID int64) bool
+}
+
+var (
+	transportMu sync.RWMutex
+	transport   Transport
+	notif       = notificator.NewNotifHandler()
+)
+
+// SetTransport daftarkan transport, transport sebelumnya akan diganti
+func SetTransport(t Transport) {
+	transportMu.Lock()
+	defer transportMu.Unlock()
+	transport = t
+}
+
+func currentTransport() Transport {
+	transportMu.RLock()
+	defer transportMu.RUnlock()
+	return transport
+}
+
+// Send satu-satunya jalur pengiriman message, baik dari http maupun socket:
+// validasi participant, simpan message, kirim ke room chat dan room user penerima
+// lalu notif jika penerima offline.
+// Penerima selalu participant lain dari chat room, bukan dari input client
+func Send(senderID int64, query repository.ChatMessageQuery) (*Reply, error) {
+	if query.Text == "" && query.AttachmentID == 0 {
+		return nil, ErrEmptyMessage
+	}
+
+	chatRepo := repository.NewChatRepository()
+	room, err := chatRepo.GetChatByID(query.ChatID)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, ErrChatNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	receiverID, ok := partnerOf(room, senderID)
+	if !ok {
+		return nil, ErrNotParticipant
+	}
+	query.ReceiverID = receiverID
+
+	if repository.NewUserRepository().IsBlockedBetween(senderID, receiverID) {
+		return nil, ErrBlocked
+	}
+
+	query.AttachmentKind = AttachmentNone
+	if query.AttachmentID != 0 {
+		// attachment hanya bisa dikirim oleh uploader ke chat room tempat file diupload
+		attachment, err := chatRepo.GetAttachmentByID(query.AttachmentID)
+		if err != nil || attachment.ChatID != room.ID || attachment.UploaderID != senderID {
+			return nil, ErrAttachmentNotFound
+		}
+		query.AttachmentKind = attachment.Kind
+	}
+
+	message, err := chatRepo.CreateChatMessage(senderID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := ToReply(message)
+	t := currentTransport()
+	if t != nil {
+		t.Push(reply)
+	}
+
+	if t == nil || !t.Online(receiverID) {
+		err := notif.NotifyFrom(senderID, receiverID, core.GotMessage, room.ID, reply, notificator.TemplateData{
+			"user":    reply.Sender.FullName,
+			"message": preview(message),
+		})
+		if err != nil {
+			log.Printf("Chat] Notify message #%d error: %s\n", message.ID, err.Error())
+		}
+	}
+
+	return reply, nil
+}
+
+// Edit ubah text message milik sender selama masih dalam EditWindow
+func Edit(senderID int64, messageID int64, text string) (*Reply, error) {
+	if text == "" {
+		return nil, ErrEmptyMessage
+	}
+
+	chatRepo := repository.NewChatRepository()
+	message, err := ownMessage(chatRepo, senderID, messageID, EditWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err = chatRepo.UpdateMessageText(message.ID, text)
+	if err != nil {
+		return nil, err
+	}
+
+	return pushUpdate(message), nil
+}
+
+// Delete hapus message. forEveryone menghapus isi message untuk semua participant,
+// hanya untuk sender selama masih dalam DeleteWindow. Selain itu message hanya
+// dihapus dari history user
+func Delete(userID int64, messageID int64, forEveryone bool) error {
+	chatRepo := repository.NewChatRepository()
+	if !forEveryone {
+		deleted, err := chatRepo.DeleteChatHistory(messageID, userID)
+		if err != nil {
+			return err
+		} else if !deleted {
+			return ErrMessageNotFound
+		}
+		return nil
+	}
+
+	message, err := ownMessage(chatRepo, userID, messageID, DeleteWindow)
+	if err != nil {
+		return err
+	}
+
+	attachmentID := message.AttachmentID
+	message, err = chatRepo.TombstoneMessage(message.ID)
+	if err != nil {
+		return err
+	}
+	if attachmentID != nil {
+		removeAttachment(*attachmentID)
+	}
+
+	pushUpdate(message)
+	return nil
+}
+
+// ownMessage message milik sender yang belum dihapus dan belum melewati window sejak dikirim
+func ownMessage(chatRepo *repository.ChatRepository, senderID int64, messageID int64, window time.Duration) (models.Message, error) {
+	message, err := chatRepo.GetMessageByID(messageID)
+	if gorm.IsRecordNotFoundError(err) || (err == nil && message.Deleted) {
+		return message, ErrMessageNotFound
+	} else if err != nil {
+		return message, err
+	}
+
+	if message.SenderID != senderID {
+		return message, ErrNotSender
+	}
+	if message.TS == nil || time.Since(*message.TS) > window {
+		return message, ErrWindowExpired
+	}
+
+	return message, nil
+}
+
+func pushUpdate(message models.Message) *Reply {
+	reply := ToReply(message)
+	if t := currentTransport(); t != nil {
+		t.PushUpdate(reply)
+	}
+	return reply
+}
+
+// MarkRead majukan read pointer user pada chat room sampai messageID
+// lalu kirim read receipt ke participant lain, messageID 0 berarti sampai message terakhir
+func MarkRead(userID int64, chatID int64, messageID int64) (models.ChatRead, error) {
+	chatRepo := repository.NewChatRepository()
+	if !chatRepo.IsChatParticipant(chatID, userID) {
+		return models.ChatRead{}, ErrNotParticipant
+	}
+
+	read, err := chatRepo.MarkChatRead(chatID, userID, messageID)
+	if err != nil {
+		return models.ChatRead{}, err
+	}
+
+	if t := currentTransport(); t != nil {
+		t.PushRead(read)
+	}
+
+	return read, nil
+}
+
+// ToReply lengkapi message dengan data sender, receiver dan url attachment
+func ToReply(message models.Message) *Reply {
+	var link *AttachmentLink
+	if message.AttachmentID != nil {
+		attachment, err := repository.NewChatRepository().GetAttachmentByID(*message.AttachmentID)
+		if err == nil {
+			link = toLink(attachment)
+		}
+	}
+
+	userRepo := repository.NewUserRepository()
+	return &Reply{
+		ID:             message.ID,
+		ChatID:         message.ChatID,
+		Sender:         userRepo.UserSimple(message.SenderID),
+		Receiver:       userRepo.UserSimple(message.ReceiverID),
+		Text:           message.Text,
+		AttachmentKind: message.AttachmentKind,
+		Attachment:     link,
+		Deleted:        message.Deleted,
+		TS:             message.TS,
+		EditedAT:       message.EditedAT,
+	}
+}
+
+// partnerOf participant lain dari chat room, false jika user bukan participant
+func partnerOf(room models.Chat, userID int64) (int64, bool) {
+	switch userID {
+	case room.InitiatorID:
+		return room.SubscriberID, true
+	case room.SubscriberID:
+		return room.InitiatorID, true
+	}
+	return 0, false
+}
+
+// preview isi notif untuk message, dipotong agar tidak terlalu panjang
+func preview(message models.Message) string {
+	const maxPreview = 100
+	text := []rune(message.Text)
+	if len(text) > maxPreview {
+		return string(text[:maxPreview]) + "..."
+	}
+	return string(text)
+}
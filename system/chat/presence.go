@@ -0,0 +1,48 @@
+package chat
+
+import (
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/jinzhu/gorm"
+)
+
+// Presence status online user, LastSeenAT diisi waktu koneksi terakhir ditutup
+type Presence struct {
+	UserID     int64      `json:"user_id"`
+	Online     bool       `json:"online"`
+	LastSeenAT *time.Time `json:"last_seen_at"`
+}
+
+// PresenceOf status online user berdasarkan koneksi socket aktif
+func PresenceOf(userID int64) Presence {
+	presence := Presence{UserID: userID}
+	if t := currentTransport(); t != nil && t.Online(userID) {
+		presence.Online = true
+		return presence
+	}
+
+	user, err := repository.NewUserRepository().GetByID(userID)
+	if err == nil {
+		presence.LastSeenAT = user.LastSeenAT
+	}
+
+	return presence
+}
+
+// PartnerPresence status online lawan chat user pada chat room
+func PartnerPresence(userID int64, chatID int64) (Presence, error) {
+	room, err := repository.NewChatRepository().GetChatByID(chatID)
+	if gorm.IsRecordNotFoundError(err) {
+		return Presence{}, ErrChatNotFound
+	} else if err != nil {
+		return Presence{}, err
+	}
+
+	partnerID, ok := partnerOf(room, userID)
+	if !ok {
+		return Presence{}, ErrNotParticipant
+	}
+
+	return PresenceOf(partnerID), nil
+}
@@ -0,0 +1,229 @@
+package cluster
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/lib/pq"
+)
+
+const (
+	// channel postgres untuk relay broadcast antar instance
+	channel = "socket_broadcast"
+	// maxPayload batas ukuran payload NOTIFY (8000 byte) dikurangi ruang untuk envelope,
+	// payload yang lebih besar disimpan di tabel socket_broadcasts
+	maxPayload = 7000
+	// heartbeatInterval jeda pembaruan heartbeat node
+	heartbeatInterval = 10 * time.Second
+	// nodeTimeout node tanpa heartbeat selama ini dianggap mati beserta presence-nya
+	nodeTimeout = 3 * heartbeatInterval
+	// spillRetention payload besar dihapus setelah semua node sempat membacanya
+	spillRetention = time.Minute
+)
+
+// Message broadcast ke room socket.io pada namespace tertentu.
+// Args berisi nilai aslinya untuk broadcast lokal dan json.RawMessage untuk broadcast dari node lain
+type Message struct {
+	Namespace string      `json:"nsp"`
+	Room      string      `json:"room"`
+	Event     string      `json:"event"`
+	Args      interface{} `json:"args"`
+}
+
+// envelope format payload NOTIFY, Ref diisi id socket_broadcasts jika payload terlalu besar
+type envelope struct {
+	Node string           `json:"node"`
+	Ref  int64            `json:"ref,omitempty"`
+	Msg  *json.RawMessage `json:"msg,omitempty"`
+}
+
+// Cluster relay broadcast socket.io antar instance melalui postgres LISTEN/NOTIFY
+// dan mencatat presence user di semua instance. Setiap instance adalah satu node
+// dengan id unik, broadcast dari node sendiri diabaikan karena sudah dikirim secara lokal.
+// Notifikasi yang dikirim selama koneksi listener terputus akan hilang,
+// sama seperti broadcast socket.io pada umumnya yang tidak menjamin pengiriman.
+type Cluster struct {
+	NodeID  string
+	deliver func(msg Message)
+
+	listener *pq.Listener
+	quit     chan bool
+	done     chan bool
+	once     sync.Once
+}
+
+// New creates new cluster node, deliver dipanggil untuk setiap broadcast
+// yang harus dikirim ke koneksi pada instance ini
+func New(deliver func(msg Message)) *Cluster {
+	return &Cluster{
+		NodeID:  newNodeID(),
+		deliver: deliver,
+		quit:    make(chan bool),
+		done:    make(chan bool),
+	}
+}
+
+// Start daftarkan node lalu mulai menerima broadcast dari node lain
+func (c *Cluster) Start() error {
+	if err := c.heartbeat(); err != nil {
+		return err
+	}
+
+	c.listener = pq.NewListener(app.DSN, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Printf("Cluster] listener error: %s\n", err.Error())
+		}
+		if ev == pq.ListenerEventReconnected {
+			log.Println("Cluster] listener reconnected, broadcasts during disconnect are lost")
+		}
+	})
+	if err := c.listener.Listen(channel); err != nil {
+		c.listener.Close()
+		return err
+	}
+
+	go c.run()
+	return nil
+}
+
+// Stop berhenti menerima broadcast dan hapus node beserta presence-nya
+func (c *Cluster) Stop() {
+	c.once.Do(func() {
+		if c.listener != nil {
+			close(c.quit)
+			<-c.done
+			c.listener.Close()
+		}
+
+		if err := app.DB.Exec(`DELETE FROM socket_nodes WHERE node_id = ?`, c.NodeID).Error; err != nil {
+			log.Printf("Cluster] remove node %s error: %s\n", c.NodeID, err.Error())
+		}
+	})
+}
+
+func (c *Cluster) run() {
+	defer close(c.done)
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.quit:
+			return
+		case n := <-c.listener.Notify:
+			// n nil setelah listener reconnect
+			if n != nil {
+				c.receive(n.Extra)
+			}
+		case <-ticker.C:
+			if err := c.heartbeat(); err != nil {
+				log.Printf("Cluster] heartbeat error: %s\n", err.Error())
+			}
+		}
+	}
+}
+
+// Broadcast kirim message ke room pada instance ini lalu relay ke node lain
+func (c *Cluster) Broadcast(namespace, room, event string, args interface{}) error {
+	msg := Message{Namespace: namespace, Room: room, Event: event, Args: args}
+	c.deliver(msg)
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	env := envelope{Node: c.NodeID}
+	if len(data) > maxPayload {
+		row := struct{ ID int64 }{}
+		err := app.DB.Raw(`INSERT INTO socket_broadcasts (payload, created_at) VALUES (?, ?) RETURNING id`,
+			string(data), time.Now().UTC()).Scan(&row).Error
+		if err != nil {
+			return err
+		}
+		env.Ref = row.ID
+	} else {
+		raw := json.RawMessage(data)
+		env.Msg = &raw
+	}
+
+	payload, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	return app.DB.Exec(`SELECT pg_notify(?, ?)`, channel, string(payload)).Error
+}
+
+// receive kirim broadcast dari node lain ke koneksi pada instance ini
+func (c *Cluster) receive(payload string) {
+	env := envelope{}
+	if err := json.Unmarshal([]byte(payload), &env); err != nil {
+		log.Printf("Cluster] invalid payload: %s\n", err.Error())
+		return
+	}
+	if env.Node == c.NodeID {
+		return
+	}
+
+	data := []byte{}
+	if env.Ref > 0 {
+		row := struct{ Payload string }{}
+		err := app.DB.Raw(`SELECT payload FROM socket_broadcasts WHERE id = ?`, env.Ref).Scan(&row).Error
+		if err != nil {
+			log.Printf("Cluster] load broadcast #%d error: %s\n", env.Ref, err.Error())
+			return
+		}
+		data = []byte(row.Payload)
+	} else if env.Msg != nil {
+		data = *env.Msg
+	}
+
+	msg := struct {
+		Message
+		Args json.RawMessage `json:"args"`
+	}{}
+	if err := json.Unmarshal(data, &msg); err != nil {
+		log.Printf("Cluster] invalid message: %s\n", err.Error())
+		return
+	}
+
+	msg.Message.Args = msg.Args
+	c.deliver(msg.Message)
+}
+
+// heartbeat perbarui heartbeat node ini lalu hapus node yang sudah mati
+// beserta presence dan payload besar yang sudah kadaluarsa
+func (c *Cluster) heartbeat() error {
+	now := time.Now().UTC()
+	err := app.DB.Exec(`
+		INSERT INTO socket_nodes (node_id, heartbeat_at) VALUES (?, ?)
+		ON CONFLICT (node_id) DO UPDATE SET heartbeat_at = EXCLUDED.heartbeat_at`, c.NodeID, now).Error
+	if err != nil {
+		return err
+	}
+
+	if err := app.DB.Exec(`DELETE FROM socket_nodes WHERE heartbeat_at < ?`, now.Add(-nodeTimeout)).Error; err != nil {
+		return err
+	}
+
+	return app.DB.Exec(`DELETE FROM socket_broadcasts WHERE created_at < ?`, now.Add(-spillRetention)).Error
+}
+
+// newNodeID id unik node, diawali hostname agar mudah dikenali di log
+func newNodeID() string {
+	host, _ := os.Hostname()
+	buf := make([]byte, 4)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%s-%x", host, time.Now().UnixNano())
+	}
+
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(buf))
+}
@@ -0,0 +1,103 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/jinzhu/gorm"
+)
+
+// connectionsQuery jumlah koneksi user pada semua node yang masih hidup
+const connectionsQuery = `
+SELECT COALESCE(SUM(p.connections), 0) AS connections FROM socket_presence p
+JOIN socket_nodes n ON n.node_id = p.node_id
+WHERE p.user_id = ? AND n.heartbeat_at >= ?`
+
+// Connected catat koneksi baru user pada node ini,
+// first bernilai true jika ini satu-satunya koneksi user di semua node
+func (c *Cluster) Connected(userID int64) (first bool, err error) {
+	err = app.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`
+			INSERT INTO socket_presence (node_id, user_id, connections) VALUES (?, ?, 1)
+			ON CONFLICT (node_id, user_id) DO UPDATE SET connections = socket_presence.connections + 1`,
+			c.NodeID, userID).Error
+		if err != nil {
+			return err
+		}
+
+		count, err := connections(tx, userID)
+		first = count == 1
+		return err
+	})
+
+	return first, err
+}
+
+// Disconnected catat koneksi user pada node ini yang ditutup,
+// last bernilai true jika user sudah tidak punya koneksi di semua node
+func (c *Cluster) Disconnected(userID int64) (last bool, err error) {
+	err = app.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`UPDATE socket_presence SET connections = connections - 1 WHERE node_id = ? AND user_id = ?`,
+			c.NodeID, userID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(`DELETE FROM socket_presence WHERE node_id = ? AND user_id = ? AND connections <= 0`,
+			c.NodeID, userID).Error
+		if err != nil {
+			return err
+		}
+
+		count, err := connections(tx, userID)
+		last = count == 0
+		return err
+	})
+
+	return last, err
+}
+
+// Online cek apakah user punya koneksi aktif di salah satu node
+func (c *Cluster) Online(userID int64) bool {
+	count, err := connections(app.DB, userID)
+	return err == nil && count > 0
+}
+
+// OnlineUsers filter user yang punya koneksi aktif di salah satu node
+func (c *Cluster) OnlineUsers(userIDs []int64) ([]int64, error) {
+	online := []int64{}
+	if len(userIDs) == 0 {
+		return online, nil
+	}
+
+	rows, err := app.DB.Raw(`
+		SELECT DISTINCT p.user_id FROM socket_presence p
+		JOIN socket_nodes n ON n.node_id = p.node_id
+		WHERE p.user_id IN (?) AND p.connections > 0 AND n.heartbeat_at >= ?`,
+		userIDs, aliveSince()).Rows()
+	if err != nil {
+		return online, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			return online, err
+		}
+		online = append(online, userID)
+	}
+
+	return online, rows.Err()
+}
+
+func connections(db *gorm.DB, userID int64) (int, error) {
+	row := struct{ Connections int }{}
+	err := db.Raw(connectionsQuery, userID, aliveSince()).Scan(&row).Error
+	return row.Connections, err
+}
+
+// aliveSince node dengan heartbeat sebelum waktu ini dianggap mati
+func aliveSince() time.Time {
+	return time.Now().UTC().Add(-nodeTimeout)
+}
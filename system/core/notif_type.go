@@ -14,4 +14,14 @@ const (
 	GotWinner NotifType = iota
 	// WinBid type when user had win the bid
 	WinBid NotifType = iota
+	// Outbid type when someone bids higher than the user
+	Outbid NotifType = iota
 )
+
+// NotifTypes semua notif type, digunakan untuk menampilkan preferensi notif
+var NotifTypes = []NotifType{GotBidder, GotMessage, BidClosed, GotWinner, WinBid, Outbid}
+
+// Valid cek apakah notif type dikenal
+func (t NotifType) Valid() bool {
+	return t >= GotBidder && t <= Outbid
+}
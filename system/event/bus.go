@@ -0,0 +1,188 @@
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
+)
+
+func init() {
+	queue.Register(&delivery{})
+}
+
+// Handler menerima event dengan tipe yang sama seperti saat Subscribe
+type Handler func(event interface{}) error
+
+// Aggregate diimplementasikan event yang harus diproses berurutan per aggregate,
+// misalnya semua event untuk product yang sama
+type Aggregate interface {
+	AggregateID() string
+}
+
+type subscriber struct {
+	name      string
+	eventType reflect.Type
+	handler   Handler
+}
+
+// Bus publish/subscribe event bus, setiap subscriber mendapat job sendiri di queue
+// sehingga kegagalan satu subscriber tidak mengulang subscriber lain
+type Bus struct {
+	mu          sync.RWMutex
+	queue       *queue.JobStore
+	subscribers map[string][]subscriber
+	byName      map[string]subscriber
+}
+
+// DefaultBus bus yang digunakan oleh Listener
+var DefaultBus = NewBus(queue.JobQueue)
+
+// NewBus creates new event bus
+func NewBus(q *queue.JobStore) *Bus {
+	return &Bus{
+		queue:       q,
+		subscribers: map[string][]subscriber{},
+		byName:      map[string]subscriber{},
+	}
+}
+
+// Subscribe mendaftarkan handler untuk tipe event yang sama dengan sample,
+// name harus unik dan tetap karena disimpan bersama job-nya.
+// Subscribe dipanggil saat init agar job yang tersimpan bisa diproses setelah restart.
+func (b *Bus) Subscribe(sample interface{}, name string, handler Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.byName[name]; exists {
+		panic(fmt.Sprintf("event subscriber `%s` already registered", name))
+	}
+
+	t := reflect.TypeOf(sample)
+	eventName := eventTypeName(t)
+
+	sub := subscriber{name: name, eventType: t, handler: handler}
+	b.subscribers[eventName] = append(b.subscribers[eventName], sub)
+	b.byName[name] = sub
+}
+
+// Publish enqueue event untuk setiap subscriber
+func (b *Bus) Publish(event interface{}) error {
+	return b.PublishTx(nil, event)
+}
+
+// PublishTx enqueue event dalam transaksi yang diberikan
+func (b *Bus) PublishTx(tx *gorm.DB, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return b.publish(tx, 0, eventTypeName(reflect.TypeOf(event)), data, aggregateOf(event))
+}
+
+// publish membuat job delivery untuk setiap subscriber, eventID 0 berarti event tidak berasal dari outbox
+func (b *Bus) publish(tx *gorm.DB, eventID int64, eventName string, data []byte, aggregateID string) error {
+	b.mu.RLock()
+	subscribers := b.subscribers[eventName]
+	b.mu.RUnlock()
+
+	for _, sub := range subscribers {
+		job := &delivery{
+			EventID:    eventID,
+			Subscriber: sub.name,
+			EventType:  eventName,
+			Event:      data,
+		}
+
+		opts := []queue.Option{}
+		if aggregateID != "" {
+			opts = append(opts, queue.Group(fmt.Sprintf("%s:%s", sub.name, aggregateID)))
+		}
+
+		var err error
+		if tx != nil {
+			err = b.queue.PushTx(tx, job, opts...)
+		} else {
+			err = b.queue.Push(job, opts...)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dispatch memanggil handler subscriber dengan event yang sudah di-decode
+func (b *Bus) dispatch(d *delivery) error {
+	b.mu.RLock()
+	sub, ok := b.byName[d.Subscriber]
+	b.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("event subscriber `%s` is not registered", d.Subscriber)
+	}
+
+	if d.EventID != 0 {
+		processed, err := isProcessed(d.EventID, d.Subscriber)
+		if err != nil {
+			return err
+		} else if processed {
+			return nil
+		}
+	}
+
+	t := sub.eventType
+	elem := t
+	if t.Kind() == reflect.Ptr {
+		elem = t.Elem()
+	}
+
+	value := reflect.New(elem)
+	if err := json.Unmarshal(d.Event, value.Interface()); err != nil {
+		return err
+	}
+
+	event := value.Elem().Interface()
+	if t.Kind() == reflect.Ptr {
+		event = value.Interface()
+	}
+	if err := sub.handler(event); err != nil {
+		return err
+	}
+
+	if d.EventID != 0 {
+		return markProcessed(d.EventID, d.Subscriber)
+	}
+	return nil
+}
+
+func aggregateOf(event interface{}) string {
+	if a, ok := event.(Aggregate); ok {
+		return a.AggregateID()
+	}
+	return ""
+}
+
+func eventTypeName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+// delivery job pengiriman satu event ke satu subscriber
+type delivery struct {
+	EventID    int64           `json:"event_id,omitempty"`
+	Subscriber string          `json:"subscriber"`
+	EventType  string          `json:"event_type"`
+	Event      json.RawMessage `json:"event"`
+}
+
+// Handle --
+func (d *delivery) Handle() error {
+	return DefaultBus.dispatch(d)
+}
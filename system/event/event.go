@@ -2,26 +2,10 @@ package event
 
 import (
 	"fmt"
-	"log"
 
 	"github.com/fatkhur1960/goauction/app/models"
-	"github.com/fatkhur1960/goauction/app/repository"
-	"github.com/fatkhur1960/goauction/app/utils"
-	"github.com/fatkhur1960/goauction/system/core"
-	"github.com/fatkhur1960/goauction/system/notificator"
 )
 
-var notif = notificator.NewNotifHandler()
-
-// StartupEvent --
-type StartupEvent struct{}
-
-// Handle startup event
-func (e StartupEvent) Handle() error {
-	// TODO: Create something here where server started
-	return nil
-}
-
 // UserRegisteredEvent is the data for when a user is created
 type UserRegisteredEvent struct {
 	FullName string `json:"full_name"`
@@ -30,12 +14,6 @@ type UserRegisteredEvent struct {
 	Token    string `json:"token"`
 }
 
-// Handle event for UserRegisteredEvent
-func (e UserRegisteredEvent) Handle() error {
-	log.Println("Event]", e.Email, "Registered")
-	return nil
-}
-
 // UserBidProductEvent is the data when user bid a product
 type UserBidProductEvent struct {
 	User    *models.User
@@ -43,32 +21,41 @@ type UserBidProductEvent struct {
 	BidData models.ProductBidder
 }
 
-// Handle event for UserBidProductEvent
-func (e *UserBidProductEvent) Handle() error {
-	notifRepo := repository.NewNotifRepository()
-	storeRepo := repository.NewStoreRepository()
-	store, _ := storeRepo.GetByID(e.Product.StoreID)
+// AggregateID bid untuk product yang sama diproses berurutan
+func (e *UserBidProductEvent) AggregateID() string {
+	return fmt.Sprintf("product:%d", e.Product.ID)
+}
 
-	title := fmt.Sprintf("Hai, %s ngebid produk Anda", e.User.FullName)
-	content := fmt.Sprintf("%s ngebid produk `%s` dengan harga %v", e.User.FullName, e.Product.ProductName, e.BidData.BidPrice)
+// ProductClosedEvent is the data when product auction is closed,
+// WinnerID is nil when nobody bid the product
+type ProductClosedEvent struct {
+	Product      models.Product `json:"product"`
+	WinnerID     *int64         `json:"winner_id"`
+	WinningPrice float64        `json:"winning_price"`
+}
+
+// AggregateID --
+func (e *ProductClosedEvent) AggregateID() string {
+	return fmt.Sprintf("product:%d", e.Product.ID)
+}
 
-	userNotif, err := notifRepo.CreateNotif(store.OwnerID, title, content, core.GotBidder, e.Product.ID)
-	if err != nil {
-		return err
-	}
+// ProductSoldEvent is the data when store marks the product as sold
+type ProductSoldEvent struct {
+	Product models.Product `json:"product"`
+}
 
-	payload := &notificator.Payload{
-		NotifID:    userNotif.ID,
-		ReceiverID: userNotif.UserID,
-		TargetID:   e.Product.ID,
-		NotifKind:  core.GotBidder,
-		Item:       &e.Product,
-		Title:      title,
-		Message:    content,
-		Created:    &utils.NOW,
-	}
+// AggregateID --
+func (e *ProductSoldEvent) AggregateID() string {
+	return fmt.Sprintf("product:%d", e.Product.ID)
+}
 
-	notif.Send(payload)
+// AuctionExtendedEvent is the data when store moves closed_at of the product forward,
+// including when a closed product is reopened
+type AuctionExtendedEvent struct {
+	Product models.Product `json:"product"`
+}
 
-	return nil
+// AggregateID --
+func (e *AuctionExtendedEvent) AggregateID() string {
+	return fmt.Sprintf("product:%d", e.Product.ID)
 }
@@ -1,24 +1,35 @@
 package event
 
 import (
-	"github.com/fatkhur1960/goauction/system/queue"
+	"log"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/jinzhu/gorm"
 )
 
 // Listener ...
 type Listener struct {
-	Queue chan queue.Queuable
+	Bus *Bus
 }
 
 // NewListener instance
-func NewListener(q chan queue.Queuable) *Listener {
+func NewListener(bus *Bus) *Listener {
 	event := &Listener{
-		Queue: q,
+		Bus: bus,
 	}
 
 	return event
 }
 
-// Emmit sends out an event with the payload
-func (l Listener) Emmit(payload interface{ Handle() error }) {
-	l.Queue <- payload
+// Emmit sends out an event with the payload through the outbox
+func (l Listener) Emmit(payload interface{}) {
+	if err := l.EmmitTx(app.DB, payload); err != nil {
+		log.Printf("Listener] can't record %T: %s\n", payload, err.Error())
+	}
+}
+
+// EmmitTx records the event in the same transaction as the state change,
+// the event is only published when the transaction is committed
+func (l Listener) EmmitTx(tx *gorm.DB, payload interface{}) error {
+	return Record(tx, payload)
 }
@@ -0,0 +1,186 @@
+package event
+
+import (
+	"encoding/json"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// relayInterval jeda pengecekan outbox yang belum dipublish
+	relayInterval  = time.Second
+	relayBatchSize = 100
+	// retention data outbox yang sudah dipublish dan data dedupe consumer
+	outboxRetention    = 7 * 24 * time.Hour
+	processedRetention = 30 * 24 * time.Hour
+	cleanupInterval    = time.Hour
+)
+
+// relayLockKey advisory lock yang dipegang selama satu batch relay. Relay berjalan di semua instance,
+// hanya satu yang mempublish dalam satu waktu agar urutan job per aggregate tetap terjaga
+const relayLockKey = 1960002
+
+// unpublishedQuery mengunci batch event yang belum dipublish
+const unpublishedQuery = `
+SELECT * FROM outbox_events
+WHERE published_at IS NULL
+ORDER BY id
+LIMIT ?
+FOR UPDATE SKIP LOCKED`
+
+// markProcessedQuery --
+const markProcessedQuery = `
+INSERT INTO processed_events (event_id, subscriber, processed_at)
+VALUES (?, ?, ?)
+ON CONFLICT DO NOTHING`
+
+// Record menyimpan event ke outbox menggunakan transaksi perubahan datanya,
+// event akan dipublish ke subscriber oleh OutboxRelay setelah transaksi di-commit
+func Record(tx *gorm.DB, event interface{}) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	row := models.OutboxEvent{
+		EventType: eventTypeName(reflect.TypeOf(event)),
+		Payload:   string(data),
+		CreatedAT: &now,
+	}
+	if aggregateID := aggregateOf(event); aggregateID != "" {
+		row.AggregateID = &aggregateID
+	}
+
+	return row.Create(tx)
+}
+
+func isProcessed(eventID int64, subscriber string) (bool, error) {
+	count, err := models.NewProcessedEventQuerySet(app.DB).
+		EventIDEq(eventID).
+		SubscriberEq(subscriber).
+		Count()
+	return count > 0, err
+}
+
+func markProcessed(eventID int64, subscriber string) error {
+	return app.DB.Exec(markProcessedQuery, eventID, subscriber, time.Now().UTC()).Error
+}
+
+// OutboxRelay mempublish event di outbox ke bus, setidaknya satu kali.
+// Event bisa terkirim lebih dari sekali, subscriber men-dedupe berdasarkan event id.
+// Relay dijalankan di semua instance, batch dikunci dengan advisory lock per transaksi
+// sehingga event tetap dipublish walaupun leader monitor sedang berganti
+type OutboxRelay struct {
+	bus  *Bus
+	quit chan bool
+	done chan bool
+	once sync.Once
+}
+
+// NewOutboxRelay instance
+func NewOutboxRelay(bus *Bus) *OutboxRelay {
+	return &OutboxRelay{
+		bus:  bus,
+		quit: make(chan bool),
+		done: make(chan bool),
+	}
+}
+
+// Start --
+func (r *OutboxRelay) Start() {
+	defer close(r.done)
+	lastCleanup := time.Time{}
+	for {
+		relayed, err := r.relay()
+		if err != nil {
+			log.Println("OutboxRelay] Got Error:", err.Error())
+		}
+
+		if time.Since(lastCleanup) > cleanupInterval {
+			r.cleanup()
+			lastCleanup = time.Now()
+		}
+
+		// batch penuh, kemungkinan masih ada event yang menunggu
+		if relayed == relayBatchSize {
+			continue
+		}
+
+		select {
+		case <-r.quit:
+			return
+		case <-time.After(relayInterval):
+		}
+	}
+}
+
+// Stop --
+func (r *OutboxRelay) Stop() {
+	r.once.Do(func() {
+		close(r.quit)
+	})
+	<-r.done
+}
+
+func (r *OutboxRelay) relay() (int, error) {
+	relayed := 0
+	err := app.DB.Transaction(func(tx *gorm.DB) error {
+		// instance lain sedang mempublish, lock dilepas ketika transaksinya selesai
+		locked := false
+		if err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", relayLockKey).Row().Scan(&locked); err != nil || !locked {
+			return err
+		}
+
+		events := []models.OutboxEvent{}
+		if err := tx.Raw(unpublishedQuery, relayBatchSize).Scan(&events).Error; err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		for _, row := range events {
+			aggregateID := ""
+			if row.AggregateID != nil {
+				aggregateID = *row.AggregateID
+			}
+
+			if err := r.bus.publish(tx, row.ID, row.EventType, []byte(row.Payload), aggregateID); err != nil {
+				return err
+			}
+
+			err := models.NewOutboxEventQuerySet(tx).IDEq(row.ID).GetUpdater().SetPublishedAT(&now).Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		relayed = len(events)
+		return nil
+	})
+
+	return relayed, err
+}
+
+func (r *OutboxRelay) cleanup() {
+	now := time.Now().UTC()
+	err := models.NewOutboxEventQuerySet(app.DB).
+		PublishedATIsNotNull().
+		PublishedATLt(now.Add(-outboxRetention)).
+		Delete()
+	if err != nil {
+		log.Println("OutboxRelay] Cleanup Error:", err.Error())
+	}
+
+	err = models.NewProcessedEventQuerySet(app.DB).
+		ProcessedATLt(now.Add(-processedRetention)).
+		Delete()
+	if err != nil {
+		log.Println("OutboxRelay] Cleanup Error:", err.Error())
+	}
+}
@@ -0,0 +1,64 @@
+package event
+
+import (
+	"log"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/jinzhu/gorm"
+)
+
+var notif = notificator.NewNotifHandler()
+
+func init() {
+	DefaultBus.Subscribe(UserRegisteredEvent{}, "log.user_registered", logUserRegistered)
+	DefaultBus.Subscribe(&UserBidProductEvent{}, "notif.user_bid_product", notifyStoreOwnerOfBid)
+	DefaultBus.Subscribe(&UserBidProductEvent{}, "notif.outbid", notifyOutbidBidder)
+}
+
+// logUserRegistered --
+func logUserRegistered(event interface{}) error {
+	e := event.(UserRegisteredEvent)
+	log.Println("Event]", e.Email, "Registered")
+	return nil
+}
+
+// notifyStoreOwnerOfBid mengirim notif ke pemilik store ketika ada yang ngebid produknya,
+// bid beruntun untuk product yang sama digabung menjadi satu notif
+func notifyStoreOwnerOfBid(event interface{}) error {
+	e := event.(*UserBidProductEvent)
+	storeRepo := repository.NewStoreRepository()
+	store, err := storeRepo.GetByID(e.Product.StoreID)
+	if err != nil {
+		return err
+	}
+
+	return notif.NotifyCoalescedFrom(e.User.ID, store.OwnerID, core.GotBidder, e.Product.ID, &e.Product, notificator.TemplateData{
+		"user":    e.User.FullName,
+		"product": e.Product.ProductName,
+		"price":   e.BidData.BidPrice,
+	})
+}
+
+// notifyOutbidBidder mengirim notif ke bidder sebelumnya ketika bid-nya dilampaui
+func notifyOutbidBidder(event interface{}) error {
+	e := event.(*UserBidProductEvent)
+	previous, err := repository.NewProductRepository().GetPreviousBid(e.Product.ID, e.BidData.ID)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// user menaikkan bid-nya sendiri
+	if previous.UserID == e.BidData.UserID {
+		return nil
+	}
+
+	return notif.NotifyCoalescedFrom(e.User.ID, previous.UserID, core.Outbid, e.Product.ID, &e.Product, notificator.TemplateData{
+		"user":    e.User.FullName,
+		"product": e.Product.ProductName,
+		"price":   e.BidData.BidPrice,
+	})
+}
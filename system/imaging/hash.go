@@ -0,0 +1,45 @@
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math/bits"
+
+	"github.com/nfnt/resize"
+)
+
+// DuplicateDistance jarak hamming maksimal antara dua hash gambar agar dianggap duplikat,
+// gambar yang sama namun diresize, dikompres ulang atau sedikit diubah warnanya masih di bawah batas ini.
+// Harus lebih kecil dari jumlah band pada index phash_bands di database
+const DuplicateDistance = 6
+
+// DHash perceptual hash (difference hash) 64 bit. Gambar dikecilkan menjadi 9x8 piksel grayscale
+// lalu setiap bit menyatakan apakah piksel lebih gelap dari piksel di sebelah kanannya,
+// sehingga hash tidak berubah walaupun ukuran atau kualitas kompresi gambar berbeda
+func DHash(img image.Image) uint64 {
+	small := resize.Resize(9, 8, img, resize.Bilinear)
+	bounds := small.Bounds()
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			left := luminance(small.At(bounds.Min.X+x, bounds.Min.Y+y))
+			right := luminance(small.At(bounds.Min.X+x+1, bounds.Min.Y+y))
+			hash <<= 1
+			if left < right {
+				hash |= 1
+			}
+		}
+	}
+
+	return hash
+}
+
+// HashDistance jumlah bit yang berbeda antara dua hash gambar
+func HashDistance(a uint64, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+func luminance(c color.Color) uint8 {
+	return color.GrayModel.Convert(c).(color.Gray).Y
+}
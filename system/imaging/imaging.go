@@ -0,0 +1,161 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/nfnt/resize"
+)
+
+const (
+	// MaxImageSize ukuran maksimal file gambar product
+	MaxImageSize = 10 << 20
+	// MinDimension lebar dan tinggi minimal gambar product dalam piksel
+	MinDimension = 320
+	// MaxDimension lebar dan tinggi maksimal gambar product dalam piksel,
+	// dicek dari header sebelum gambar didecode
+	MaxDimension = 8000
+	// ThumbSize sisi terpanjang variant thumbnail
+	ThumbSize = 240
+	// MediumSize sisi terpanjang variant medium
+	MediumSize = 800
+
+	originalQuality = 92
+	variantQuality  = 85
+)
+
+var (
+	// ErrImageTooLarge ukuran file melebihi MaxImageSize
+	ErrImageTooLarge = fmt.Errorf("Ukuran gambar maksimal %d MB", MaxImageSize>>20)
+	// ErrImageType format gambar tidak didukung
+	ErrImageType = errors.New("Format gambar tidak didukung, gunakan jpeg atau png")
+	// ErrImageDimension dimensi gambar di luar batas
+	ErrImageDimension = fmt.Errorf("Dimensi gambar minimal %dx%d dan maksimal %dx%d piksel",
+		MinDimension, MinDimension, MaxDimension, MaxDimension)
+)
+
+// Variant satu ukuran gambar yang sudah diencode dan siap disimpan
+type Variant struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+	Width       int
+	Height      int
+}
+
+// Processed hasil proses gambar upload, semua variant sudah tanpa metadata exif
+type Processed struct {
+	Original Variant
+	Medium   Variant
+	Thumb    Variant
+	// Image gambar original yang sudah didecode dan diputar sesuai orientation exif
+	Image image.Image
+	// Hash perceptual hash gambar untuk mendeteksi gambar yang sama di product lain
+	Hash uint64
+}
+
+// Process validasi format dan dimensi gambar lalu buat variant original, medium dan thumbnail.
+// Semua variant diencode ulang sehingga metadata exif (lokasi, kamera, dll) tidak ikut tersimpan,
+// orientation exif diterapkan lebih dulu agar gambar tidak terbalik setelah exif dibuang
+func Process(data []byte) (*Processed, error) {
+	if len(data) > MaxImageSize {
+		return nil, ErrImageTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, ErrImageType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	if !validDimension(config.Width) || !validDimension(config.Height) {
+		return nil, ErrImageDimension
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrImageType
+	}
+	if contentType == "image/jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+
+	// hash dihitung dari gambar berlatar putih agar png transparan sama dengan versi jpeg-nya
+	processed := &Processed{Image: img, Hash: DHash(flatten(img))}
+	if contentType == "image/png" {
+		processed.Original, err = encodePNG(img)
+	} else {
+		processed.Original, err = encodeJPEG(img, originalQuality)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	processed.Medium, err = encodeJPEG(resize.Thumbnail(MediumSize, MediumSize, img, resize.Lanczos3), variantQuality)
+	if err != nil {
+		return nil, err
+	}
+	processed.Thumb, err = encodeJPEG(resize.Thumbnail(ThumbSize, ThumbSize, img, resize.Lanczos3), variantQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	return processed, nil
+}
+
+func validDimension(size int) bool {
+	return size >= MinDimension && size <= MaxDimension
+}
+
+// flatten gambar dengan bagian transparan diganti latar putih
+func flatten(img image.Image) *image.RGBA {
+	bounds := img.Bounds()
+	flat := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(flat, flat.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), img, bounds.Min, draw.Over)
+
+	return flat
+}
+
+// encodeJPEG encode gambar ke jpeg, bagian transparan diganti latar putih
+func encodeJPEG(img image.Image, quality int) (Variant, error) {
+	bounds := img.Bounds()
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, flatten(img), &jpeg.Options{Quality: quality}); err != nil {
+		return Variant{}, err
+	}
+
+	return Variant{
+		Data:        buf.Bytes(),
+		ContentType: "image/jpeg",
+		Ext:         ".jpg",
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
+
+func encodePNG(img image.Image) (Variant, error) {
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, img); err != nil {
+		return Variant{}, err
+	}
+
+	bounds := img.Bounds()
+	return Variant{
+		Data:        buf.Bytes(),
+		ContentType: "image/png",
+		Ext:         ".png",
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
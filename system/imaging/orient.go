@@ -0,0 +1,67 @@
+package imaging
+
+import (
+	"bytes"
+	"image"
+
+	"github.com/rwcarlsen/goexif/exif"
+)
+
+// exifOrientation nilai orientation exif (1-8), 1 jika tidak ada atau tidak valid
+func exifOrientation(data []byte) int {
+	x, err := exif.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 1
+	}
+	tag, err := x.Get(exif.Orientation)
+	if err != nil {
+		return 1
+	}
+	orientation, err := tag.Int(0)
+	if err != nil {
+		return 1
+	}
+
+	return orientation
+}
+
+// orient putar atau balik gambar sesuai orientation exif sehingga tampil tegak tanpa exif
+func orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	dw, dh := w, h
+	// orientation 5-8 menukar lebar dan tinggi
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2: // flip horizontal
+				dx, dy = w-1-x, y
+			case 3: // putar 180
+				dx, dy = w-1-x, h-1-y
+			case 4: // flip vertical
+				dx, dy = x, h-1-y
+			case 5: // transpose
+				dx, dy = y, x
+			case 6: // putar 90 searah jarum jam
+				dx, dy = h-1-y, x
+			case 7: // transverse
+				dx, dy = h-1-y, w-1-x
+			case 8: // putar 90 berlawanan jarum jam
+				dx, dy = y, w-1-x
+			}
+			dst.Set(dx, dy, img.At(bounds.Min.X+x, bounds.Min.Y+y))
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,160 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/fatkhur1960/goauction/system/storage"
+)
+
+const (
+	// productPrefix prefix key object gambar product, hanya key dengan prefix ini yang bisa diakses publik
+	productPrefix = "products/"
+	thumbName     = "thumb.jpg"
+	mediumName    = "medium.jpg"
+)
+
+// PendingImageTTL gambar yang tidak dipasang ke product dalam waktu ini akan dihapus
+var PendingImageTTL = 24 * time.Hour
+
+// ErrImageNotFound gambar tidak ditemukan
+var ErrImageNotFound = errors.New("Gambar tidak ditemukan")
+
+func init() {
+	queue.Register(&PrunePendingImageJob{})
+}
+
+// PrunePendingImageJob hapus gambar upload yang tidak pernah dipasang ke product
+type PrunePendingImageJob struct {
+	ImageID int64 `json:"image_id"`
+}
+
+// Handle --
+func (j *PrunePendingImageJob) Handle() error {
+	productRepo := repository.NewProductRepository()
+	image, err := productRepo.GetProductImage(j.ImageID)
+	if err != nil || image.ProductID != nil {
+		return nil
+	}
+
+	deleted, err := productRepo.DeletePendingProductImage(image.ID)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		RemoveObjects(image)
+	}
+	return nil
+}
+
+// UploadProductImage proses gambar lalu simpan original beserta variant-nya ke storage.
+// Gambar belum dipasang ke product, pasang dengan image_ids saat add atau update product
+func UploadProductImage(uploaderID int64, file io.Reader, size int64) (models.ProductImage, error) {
+	if size > MaxImageSize {
+		return models.ProductImage{}, ErrImageTooLarge
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(file, MaxImageSize+1))
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+	processed, err := Process(data)
+	if err != nil {
+		return models.ProductImage{}, err
+	}
+
+	dir := productPrefix + storage.RandomName()
+	originalKey := dir + "/original" + processed.Original.Ext
+	objects := []struct {
+		key     string
+		variant Variant
+	}{
+		{originalKey, processed.Original},
+		{dir + "/" + mediumName, processed.Medium},
+		{dir + "/" + thumbName, processed.Thumb},
+	}
+	for i, object := range objects {
+		v := object.variant
+		if err := storage.Default().Put(object.key, bytes.NewReader(v.Data), int64(len(v.Data)), v.ContentType); err != nil {
+			for _, stored := range objects[:i] {
+				storage.Default().Delete(stored.key)
+			}
+			return models.ProductImage{}, err
+		}
+	}
+
+	hash := int64(processed.Hash)
+	image, err := repository.NewProductRepository().CreateProductImage(models.ProductImage{
+		UploaderID: &uploaderID,
+		StorageKey: originalKey,
+		ImageURL:   FileURL(originalKey),
+		MediumURL:  FileURL(dir + "/" + mediumName),
+		ThumbURL:   FileURL(dir + "/" + thumbName),
+		Width:      processed.Original.Width,
+		Height:     processed.Original.Height,
+		Phash:      &hash,
+	})
+	if err != nil {
+		RemoveObjects(models.ProductImage{StorageKey: originalKey})
+		return models.ProductImage{}, err
+	}
+
+	err = queue.JobQueue.Push(&PrunePendingImageJob{ImageID: image.ID}, queue.RunAt(time.Now().Add(PendingImageTTL)))
+	if err != nil {
+		log.Printf("Imaging] schedule prune image #%d error: %s\n", image.ID, err.Error())
+	}
+
+	return image, nil
+}
+
+// OpenProductImage buka object gambar product, key di luar prefix gambar product ditolak
+func OpenProductImage(key string) (io.ReadCloser, string, error) {
+	if !strings.HasPrefix(key, productPrefix) || path.Clean(key) != key {
+		return nil, "", ErrImageNotFound
+	}
+
+	file, err := storage.Default().Get(key)
+	if err == storage.ErrObjectNotFound {
+		return nil, "", ErrImageNotFound
+	} else if err != nil {
+		return nil, "", err
+	}
+
+	contentType := "image/jpeg"
+	if path.Ext(key) == ".png" {
+		contentType = "image/png"
+	}
+	return file, contentType, nil
+}
+
+// RemoveObjects hapus file original beserta variant gambar dari storage,
+// gambar lama berupa url eksternal tidak punya storage key
+func RemoveObjects(images ...models.ProductImage) {
+	for _, image := range images {
+		if image.StorageKey == "" {
+			continue
+		}
+
+		dir := path.Dir(image.StorageKey)
+		for _, key := range []string{image.StorageKey, dir + "/" + mediumName, dir + "/" + thumbName} {
+			if err := storage.Default().Delete(key); err != nil {
+				log.Printf("Imaging] delete object %s error: %s\n", key, err.Error())
+			}
+		}
+	}
+}
+
+// FileURL url publik object gambar product
+func FileURL(key string) string {
+	return "/api/product-image/v1/file?key=" + url.QueryEscape(key)
+}
@@ -0,0 +1,114 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+)
+
+// checkInterval jeda percobaan mengambil lock dan pengecekan koneksi leader
+const checkInterval = 5 * time.Second
+
+// Elector memilih satu leader di antara semua instance menggunakan
+// postgres session advisory lock. Lock dipegang oleh koneksi khusus,
+// jika koneksi atau instance mati postgres otomatis melepas lock
+// dan instance lain akan mengambil alih pada pengecekan berikutnya.
+// Karena kehilangan lock baru terdeteksi pada pengecekan berikutnya,
+// pekerjaan yang dijalankan leader tetap harus idempotent.
+type Elector struct {
+	Name      string
+	key       int64
+	onElected func()
+	onDemoted func()
+
+	conn *sql.Conn
+	quit chan bool
+	done chan bool
+	once sync.Once
+}
+
+// NewElector creates new elector, onElected dipanggil ketika instance ini menjadi leader
+// dan onDemoted ketika kehilangan leadership atau saat Stop
+func NewElector(name string, key int64, onElected, onDemoted func()) *Elector {
+	return &Elector{
+		Name:      name,
+		key:       key,
+		onElected: onElected,
+		onDemoted: onDemoted,
+		quit:      make(chan bool),
+		done:      make(chan bool),
+	}
+}
+
+// Start menjalankan pemilihan leader sampai Stop dipanggil
+func (e *Elector) Start() {
+	go func() {
+		defer close(e.done)
+		for {
+			if e.conn == nil {
+				e.campaign()
+			} else if err := e.conn.PingContext(context.Background()); err != nil {
+				log.Printf("Leader] %s lost leadership: %s\n", e.Name, err.Error())
+				e.resign()
+			}
+
+			select {
+			case <-e.quit:
+				e.resign()
+				return
+			case <-time.After(checkInterval):
+			}
+		}
+	}()
+}
+
+// Stop melepas leadership dan menghentikan pemilihan
+func (e *Elector) Stop() {
+	e.once.Do(func() {
+		close(e.quit)
+	})
+	<-e.done
+}
+
+func (e *Elector) campaign() {
+	ctx := context.Background()
+	conn, err := app.DB.DB().Conn(ctx)
+	if err != nil {
+		log.Printf("Leader] %s can't get connection: %s\n", e.Name, err.Error())
+		return
+	}
+
+	acquired := false
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&acquired)
+	if err != nil || !acquired {
+		if err != nil {
+			log.Printf("Leader] %s can't acquire lock: %s\n", e.Name, err.Error())
+		}
+		conn.Close()
+		return
+	}
+
+	log.Printf("Leader] %s elected\n", e.Name)
+	e.conn = conn
+	e.onElected()
+}
+
+func (e *Elector) resign() {
+	if e.conn == nil {
+		return
+	}
+
+	e.onDemoted()
+
+	// koneksi dikembalikan ke pool, jadi lock harus dilepas secara eksplisit
+	if _, err := e.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+		log.Printf("Leader] %s can't release lock: %s\n", e.Name, err.Error())
+	}
+	e.conn.Close()
+	e.conn = nil
+	log.Printf("Leader] %s resigned\n", e.Name)
+}
@@ -0,0 +1,67 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
+)
+
+func init() {
+	queue.Register(&CloseProductJob{})
+}
+
+// CloseProductJob menutup lelang product ketika closed_at tercapai
+type CloseProductJob struct {
+	ProductID int64 `json:"product_id"`
+}
+
+// Handle --
+func (j *CloseProductJob) Handle() error {
+	product := models.Product{}
+	err := models.NewProductQuerySet(app.DB).IDEq(j.ProductID).One(&product)
+	if gorm.IsRecordNotFoundError(err) {
+		// product sudah dihapus
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// closed_at bisa saja dimundurkan setelah job ini dibuat, job untuk closed_at yang baru
+	// sudah menggantikannya atau diantrikan sebagai follow-up jika job ini sedang berjalan
+	if product.Closed || product.ClosedAT == nil || product.ClosedAT.After(time.Now().UTC()) {
+		return nil
+	}
+
+	p := &ProductMonitor{
+		repo:  models.NewProductQuerySet(app.DB),
+		notif: notificator.NewNotifHandler(),
+	}
+	return p.processCloseProduct([]models.Product{product})
+}
+
+// ScheduleProductClose enqueue job penutupan product pada closed_at-nya,
+// job sebelumnya untuk product yang sama akan diganti
+func ScheduleProductClose(product *models.Product) {
+	if product.ClosedAT == nil {
+		return
+	}
+
+	err := queue.JobQueue.Push(
+		&CloseProductJob{ProductID: product.ID},
+		queue.RunAt(*product.ClosedAT),
+		queue.Key(closeProductKey(product.ID)),
+	)
+	if err != nil {
+		log.Printf("ProductMonitor] Can't schedule closing of product #%d: %s\n", product.ID, err.Error())
+	}
+}
+
+func closeProductKey(productID int64) string {
+	return fmt.Sprintf("close-product:%d", productID)
+}
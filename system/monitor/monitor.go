@@ -1,24 +1,121 @@
 package monitor
 
 import (
+	"context"
 	"log"
 	"reflect"
+	"sync"
 	"time"
+
+	"github.com/fatkhur1960/goauction/system/event"
+	"github.com/fatkhur1960/goauction/system/leader"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/fatkhur1960/goauction/system/queue"
 )
 
+// monitorLockKey advisory lock key yang dipegang instance leader monitor
+const monitorLockKey = 1960001
+
 // Monitor ... Abstraksi untuk sistem monitor
 type Monitor interface {
 	Start()
 	Stop()
 }
 
-// StartMonitors Run all monitors
-func StartMonitors() {
-	monitors := []Monitor{NewProductMonitor()}
+var (
+	mu       sync.Mutex
+	running  []Monitor
+	elector  *leader.Elector
+	relay    *event.OutboxRelay
+	stopping bool
+)
 
+// newMonitors daftar monitor, dibuat ulang setiap kali instance ini terpilih menjadi leader
+func newMonitors() []Monitor {
+	return []Monitor{
+		NewProductMonitor(),
+	}
+}
+
+// ScheduleJobs daftarkan job berkala ke queue, dipanggil sekali saat startup.
+// Spec cron dievaluasi dalam UTC, lihat queue.Schedule
+func ScheduleJobs() {
+	// 02:30 UTC
+	err := queue.JobQueue.Schedule("notif-retention", "30 2 * * *", &NotifRetentionJob{RetentionDays: notifRetentionDays()})
+	if err != nil {
+		log.Printf("Monitor] Can't schedule notif retention: %s\n", err.Error())
+	}
+
+	// 00:00 UTC = 07:00 WIB
+	if err := queue.JobQueue.Schedule("notif-digest", "0 0 * * *", &notificator.DigestJob{}); err != nil {
+		log.Printf("Monitor] Can't schedule notif digest: %s\n", err.Error())
+	}
+}
+
+// StartMonitors Run all monitors on the leader instance only,
+// outbox relay berjalan di semua instance agar event tidak tertahan selama pergantian leader
+func StartMonitors() {
 	time.Sleep(5 * time.Second)
-	for _, monitor := range monitors {
+
+	mu.Lock()
+	defer mu.Unlock()
+	if stopping {
+		return
+	}
+
+	relay = event.NewOutboxRelay(event.DefaultBus)
+	go relay.Start()
+
+	elector = leader.NewElector("monitor", monitorLockKey, runMonitors, stopMonitors)
+	elector.Start()
+}
+
+// StopMonitors stop all running monitors, waiting for the current round to finish
+func StopMonitors(ctx context.Context) error {
+	mu.Lock()
+	stopping = true
+	e := elector
+	r := relay
+	mu.Unlock()
+
+	stopped := make(chan bool)
+	go func() {
+		if e != nil {
+			// Stop memanggil stopMonitors sebelum melepas lock
+			e.Stop()
+		}
+		if r != nil {
+			r.Stop()
+		}
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func runMonitors() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, monitor := range newMonitors() {
 		log.Printf("Monitor] Starting `%s`...\n", reflect.TypeOf(monitor).String())
 		go monitor.Start()
+		running = append(running, monitor)
+	}
+}
+
+func stopMonitors() {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, monitor := range running {
+		log.Printf("Monitor] Stopping `%s`...\n", reflect.TypeOf(monitor).String())
+		monitor.Stop()
 	}
+	running = nil
 }
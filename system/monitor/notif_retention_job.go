@@ -0,0 +1,49 @@
+package monitor
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/queue"
+)
+
+// defaultNotifRetentionDays umur notif yang sudah dibaca sebelum dihapus,
+// bisa diganti dengan NOTIF_RETENTION_DAYS
+const defaultNotifRetentionDays = 90
+
+func init() {
+	queue.Register(&NotifRetentionJob{})
+}
+
+// NotifRetentionJob menghapus notif lama yang sudah dibaca
+type NotifRetentionJob struct {
+	RetentionDays int `json:"retention_days"`
+}
+
+// Handle --
+func (j *NotifRetentionJob) Handle() error {
+	days := j.RetentionDays
+	if days <= 0 {
+		days = defaultNotifRetentionDays
+	}
+
+	before := time.Now().UTC().AddDate(0, 0, -days)
+	count, err := repository.NewNotifRepository().PruneReadNotifs(before)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("NotifRetention] %d read notifs older than %d days pruned\n", count, days)
+	return nil
+}
+
+func notifRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("NOTIF_RETENTION_DAYS"))
+	if err != nil || days <= 0 {
+		return defaultNotifRetentionDays
+	}
+	return days
+}
@@ -1,137 +1,155 @@
 package monitor
 
 import (
-	"fmt"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/fatkhur1960/goauction/app"
-	"github.com/fatkhur1960/goauction/app/middleware"
 	"github.com/fatkhur1960/goauction/app/models"
 	"github.com/fatkhur1960/goauction/app/repository"
-	"github.com/fatkhur1960/goauction/app/utils"
 	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/event"
 	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/jinzhu/gorm"
 )
 
 // ProductMonitor --
 type ProductMonitor struct {
 	repo  models.ProductQuerySet
 	notif *notificator.NotifHandler
+	quit  chan bool
+	done  chan bool
+	once  sync.Once
 }
 
+// reconcileInterval jeda pengecekan ulang product yang belum memiliki job penutupan
+const reconcileInterval = 10 * time.Minute
+
+// inspectProduct memastikan setiap product yang masih dibuka punya CloseProductJob,
+// misalnya product yang dibuat sebelum job queue digunakan
 func (p *ProductMonitor) inspectProduct() error {
 	products := []models.Product{}
 
 	log.Println("ProductMonitor] inspecting products...")
 
-	now := time.Now().UTC()
-	log.Println("ProductMonitor] inspectProduct now:", now)
-
-	if err := p.repo.ClosedEq(false).ClosedATLte(now).All(&products); err != nil {
+	if err := p.repo.ClosedEq(false).All(&products); err != nil {
 		return err
 	}
 
-	return p.processCloseProduct(products)
+	for _, product := range products {
+		ScheduleProductClose(&product)
+	}
+
+	return nil
 }
 
 func (p *ProductMonitor) processCloseProduct(products []models.Product) error {
+	productRepo := repository.NewProductRepository()
 	for _, product := range products {
-		log.Printf("ProductMonitor] Closing product with name: `%s`", product.ProductName)
-		p.createNotifs(&product)
-		err := p.repo.IDEq(product.ID).GetUpdater().SetClosed(true).Update()
+		// hanya pemanggil yang berhasil mengubah closed=false menjadi true yang mengirim notif,
+		// sehingga product yang ditutup bersamaan oleh dua instance tidak mendapat notif ganda
+		closed, err := productRepo.CloseProduct(product.ID, func(tx *gorm.DB) error {
+			return event.Record(tx, closedEvent(&product))
+		})
 		if err != nil {
 			return err
+		} else if !closed {
+			continue
+		}
+
+		log.Printf("ProductMonitor] Closing product with name: `%s`", product.ProductName)
+		product.Closed = true
+		if err := p.createNotifs(&product); err != nil {
+			log.Printf("ProductMonitor] create notifs got error: %s\n", err.Error())
 		}
 	}
 
 	return nil
 }
 
+// closedEvent --
+func closedEvent(product *models.Product) *event.ProductClosedEvent {
+	closed := *product
+	closed.Closed = true
+	e := &event.ProductClosedEvent{Product: closed}
+
+	bidStatus := product.GetBidderStatus(nil)
+	if bidStatus.BidCount != 0 {
+		winnerID := bidStatus.LatestUserID
+		e.WinnerID = &winnerID
+		e.WinningPrice = bidStatus.LatestBidPrice
+	}
+
+	return e
+}
+
 func (p *ProductMonitor) createNotifs(product *models.Product) error {
-	notifRepo := repository.NewNotifRepository()
 	userRepo := repository.NewUserRepository()
 	storeRepo := repository.NewStoreRepository()
-	bidStatus := product.GetBidderStatus(&middleware.CurrentUser.ID)
-	uid := bidStatus.LatestUserID
-	price := bidStatus.LatestBidPrice
-	store, _ := storeRepo.GetByID(product.StoreID)
+	// monitor tidak punya viewer, hanya status bid product yang dibutuhkan
+	bidStatus := product.GetBidderStatus(nil)
+	store, err := storeRepo.GetByID(product.StoreID)
+	if err != nil {
+		return err
+	}
 
-	if bidStatus.BidCount != 0 {
+	if bidStatus.BidCount == 0 {
 		// create notif for product creator
-		user, _ := userRepo.GetByID(uid)
-		title := fmt.Sprintf("%s ditutup", product.ProductName)
-		content := fmt.Sprintf("%s memenangkan bid Anda dengan harga %v", user.FullName, price)
-		ownerNotif, _ := notifRepo.CreateNotif(store.OwnerID, title, content, core.GotWinner, product.ID)
-
-		payload1 := &notificator.Payload{
-			NotifID:    ownerNotif.ID,
-			ReceiverID: ownerNotif.UserID,
-			TargetID:   product.ID,
-			NotifKind:  core.GotBidder,
-			Item:       &product,
-			Title:      title,
-			Message:    content,
-			Created:    &utils.NOW,
-		}
-		p.notif.Send(payload1)
-
-		// create notif for bidder
-		title = fmt.Sprintf("Selamat Anda menangkan bid untuk %s", product.ProductName)
-		content = fmt.Sprintf("Anda memenangkan bid dengan harga %v", price)
-		userNotif, _ := notifRepo.CreateNotif(bidStatus.LatestUserID, title, content, core.WinBid, product.ID)
-
-		payload2 := &notificator.Payload{
-			NotifID:    userNotif.ID,
-			ReceiverID: userNotif.UserID,
-			TargetID:   product.ID,
-			NotifKind:  core.GotBidder,
-			Item:       &product,
-			Title:      title,
-			Message:    content,
-			Created:    &utils.NOW,
-		}
-		p.notif.Send(payload2)
-	} else {
-		// create notif for product creator
-		title := fmt.Sprintf("%s ditutup", product.ProductName)
-		content := "Belum ada pemenang untuk bid ini"
-		userNotif, _ := notifRepo.CreateNotif(store.OwnerID, title, content, core.GotWinner, product.ID)
-
-		payload := &notificator.Payload{
-			NotifID:    userNotif.ID,
-			ReceiverID: userNotif.UserID,
-			TargetID:   product.ID,
-			NotifKind:  core.GotBidder,
-			Item:       &product,
-			Title:      title,
-			Message:    content,
-			Created:    &utils.NOW,
-		}
-		p.notif.Send(payload)
+		return p.notif.Notify(store.OwnerID, core.BidClosed, product.ID, product, notificator.TemplateData{
+			"product": product.ProductName,
+		})
 	}
 
-	return nil
+	// create notif for product creator
+	user, _ := userRepo.GetByID(bidStatus.LatestUserID)
+	err = p.notif.Notify(store.OwnerID, core.GotWinner, product.ID, product, notificator.TemplateData{
+		"user":    user.FullName,
+		"product": product.ProductName,
+		"price":   bidStatus.LatestBidPrice,
+	})
+	if err != nil {
+		return err
+	}
+
+	// create notif for bidder
+	return p.notif.Notify(bidStatus.LatestUserID, core.WinBid, product.ID, product, notificator.TemplateData{
+		"product": product.ProductName,
+		"price":   bidStatus.LatestBidPrice,
+	})
 }
 
 // Start --
 func (p *ProductMonitor) Start() {
+	defer close(p.done)
 	for {
 		log.Println("ProductMonitor] monitor checking...")
 		if err := p.inspectProduct(); err != nil {
-			log.Fatalf("check product got error: %s\n", err.Error())
+			log.Printf("ProductMonitor] check product got error: %s\n", err.Error())
+		}
+
+		select {
+		case <-p.quit:
+			return
+		case <-time.After(reconcileInterval):
 		}
-		time.Sleep(5 * time.Second)
 	}
 }
 
-// Stop --
-func (p *ProductMonitor) Stop() {}
+// Stop menghentikan monitor setelah pengecekan yang sedang berjalan selesai
+func (p *ProductMonitor) Stop() {
+	p.once.Do(func() {
+		close(p.quit)
+	})
+	<-p.done
+}
 
 // NewProductMonitor instance
 func NewProductMonitor() Monitor {
 	return &ProductMonitor{
 		repo:  models.NewProductQuerySet(app.DB),
 		notif: notificator.NewNotifHandler(),
+		quit:  make(chan bool),
+		done:  make(chan bool),
 	}
 }
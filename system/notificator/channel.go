@@ -0,0 +1,76 @@
+package notificator
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/fatkhur1960/goauction/system/core"
+)
+
+// Nama channel yang bisa dipilih user
+const (
+	ChannelPush    = "push"
+	ChannelEmail   = "email"
+	ChannelSocket  = "socket"
+	ChannelWebhook = "webhook"
+)
+
+// ErrNoRecipient dikembalikan channel ketika user tidak bisa dihubungi melalui channel tersebut,
+// eg: tidak ada push device, sehingga fallback channel akan digunakan
+var ErrNoRecipient = errors.New("no recipient for this channel")
+
+// Channel abstraksi untuk media pengiriman notif
+type Channel interface {
+	Name() string
+	Send(payload *Payload) error
+}
+
+var (
+	channelsMu sync.RWMutex
+	channels   = map[string]Channel{}
+
+	// fallbacks channel pengganti ketika channel utama tidak punya penerima
+	fallbacks = map[string]string{
+		ChannelPush: ChannelEmail,
+	}
+
+	// mutedOnQuietHours channel yang tidak digunakan selama quiet hours,
+	// notif tetap masuk ke inbox dan channel in-app
+	mutedOnQuietHours = map[string]bool{
+		ChannelPush:  true,
+		ChannelEmail: true,
+	}
+)
+
+func init() {
+	RegisterChannel(newFCMChannel())
+	RegisterChannel(newEmailChannel())
+}
+
+// RegisterChannel mendaftarkan channel, channel dengan nama yang sama akan diganti
+func RegisterChannel(ch Channel) {
+	channelsMu.Lock()
+	defer channelsMu.Unlock()
+	channels[ch.Name()] = ch
+}
+
+func getChannel(name string) (Channel, bool) {
+	channelsMu.RLock()
+	defer channelsMu.RUnlock()
+	ch, ok := channels[name]
+	return ch, ok
+}
+
+// ValidChannel --
+func ValidChannel(name string) bool {
+	switch name {
+	case ChannelPush, ChannelEmail, ChannelSocket, ChannelWebhook:
+		return true
+	}
+	return false
+}
+
+// DefaultChannels channel yang digunakan ketika user belum mengatur preferensi
+func DefaultChannels(notifType core.NotifType) []string {
+	return []string{ChannelPush, ChannelSocket}
+}
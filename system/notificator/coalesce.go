@@ -0,0 +1,99 @@
+package notificator
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
+)
+
+// CoalesceWindow notif sejenis untuk penerima dan target yang sama dalam satu window digabung
+const CoalesceWindow = time.Minute
+
+func init() {
+	queue.Register(&CoalescedNotifJob{})
+}
+
+// CoalescedNotifJob mengirim notif yang dikumpulkan selama satu window
+type CoalescedNotifJob struct {
+	ReceiverID int64          `json:"receiver_id"`
+	NotifType  core.NotifType `json:"notif_type"`
+	TargetID   int64          `json:"target_id"`
+}
+
+// Handle --
+func (j *CoalescedNotifJob) Handle() error {
+	return NewNotifHandler().flushBuffered(j.ReceiverID, j.NotifType, j.TargetID)
+}
+
+// NotifyCoalesced seperti Notify, namun notif sejenis untuk penerima dan target yang sama
+// dikumpulkan lalu dikirim sebagai satu notif di akhir CoalesceWindow
+func (h *NotifHandler) NotifyCoalesced(receiverID int64, notifType core.NotifType, targetID int64, item interface{}, data TemplateData) error {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	rawItem, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+
+	if err := h.notifRepo.BufferNotif(receiverID, notifType, targetID, string(rawData), string(rawItem)); err != nil {
+		return err
+	}
+
+	// window dibagi per bucket waktu yang tetap sehingga bid beruntun tidak menunda notif terus-menerus,
+	// bucket yang sudah lewat punya key berbeda sehingga tidak bentrok dengan job yang sedang berjalan
+	flushAt := time.Now().UTC().Truncate(CoalesceWindow).Add(CoalesceWindow)
+	key := fmt.Sprintf("notif-coalesce:%d:%d:%d:%d", receiverID, notifType, targetID, flushAt.Unix())
+	return queue.JobQueue.Push(
+		&CoalescedNotifJob{ReceiverID: receiverID, NotifType: notifType, TargetID: targetID},
+		queue.RunAt(flushAt),
+		queue.Key(key),
+	)
+}
+
+// NotifyCoalescedFrom seperti NotifyCoalesced untuk notif yang dipicu oleh actor,
+// notif tidak dikirim jika penerima memblokir actor
+func (h *NotifHandler) NotifyCoalescedFrom(actorID int64, receiverID int64, notifType core.NotifType, targetID int64, item interface{}, data TemplateData) error {
+	if h.userRepo.HasBlocked(receiverID, actorID) {
+		return nil
+	}
+
+	return h.NotifyCoalesced(receiverID, notifType, targetID, item, data)
+}
+
+// flushBuffered kirim notif yang terkumpul, satu notif dirender seperti biasa
+// sedangkan beberapa notif dirender dengan template gabungan.
+// Buffer diambil, notif disimpan dan job pengiriman diantrikan dalam satu transaksi
+// sehingga buffer tidak hilang jika salah satunya gagal
+func (h *NotifHandler) flushBuffered(receiverID int64, notifType core.NotifType, targetID int64) error {
+	locale := h.localeOf(receiverID)
+
+	return h.notifRepo.TakeBufferedNotifs(receiverID, notifType, targetID, func(tx *gorm.DB, buffers []models.NotifBuffer) error {
+		latest := buffers[len(buffers)-1]
+		data := TemplateData{}
+		if err := json.Unmarshal([]byte(latest.Data), &data); err != nil {
+			return err
+		}
+		item := json.RawMessage(latest.Item)
+
+		var title, message string
+		var err error
+		if len(buffers) == 1 {
+			title, message, err = Render(notifType, locale, data)
+		} else {
+			data["count"] = len(buffers)
+			title, message, err = RenderCoalesced(notifType, locale, data)
+		}
+		if err != nil {
+			return err
+		}
+
+		return h.deliverTx(tx, receiverID, notifType, targetID, item, title, message)
+	})
+}
@@ -0,0 +1,94 @@
+package notificator
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/queue"
+)
+
+// digestPeriod rentang aktivitas yang dirangkum, juga batas lelang yang segera ditutup
+const digestPeriod = 24 * time.Hour
+
+func init() {
+	queue.Register(&DigestJob{}, &UserDigestJob{})
+}
+
+// DigestJob dijalankan harian, membuat UserDigestJob untuk setiap user yang memilih email ringkasan
+type DigestJob struct{}
+
+// Handle --
+func (j *DigestJob) Handle() error {
+	userIDs, err := repository.NewNotifRepository().GetDigestUserIDs()
+	if err != nil {
+		return err
+	}
+
+	until := time.Now().UTC().Truncate(time.Hour)
+	for _, userID := range userIDs {
+		err := queue.JobQueue.Push(
+			&UserDigestJob{UserID: userID, Until: until},
+			queue.Key(fmt.Sprintf("digest:%d:%s", userID, until.Format("2006-01-02"))),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("NotifDigest] %d digests enqueued\n", len(userIDs))
+	return nil
+}
+
+// UserDigestJob mengirim email ringkasan bid, lelang yang dimenangkan
+// dan lelang yang diikuti user yang segera ditutup
+type UserDigestJob struct {
+	UserID int64     `json:"user_id"`
+	Until  time.Time `json:"until"`
+}
+
+// Handle --
+func (j *UserDigestJob) Handle() error {
+	productRepo := repository.NewProductRepository()
+	since := j.Until.Add(-digestPeriod)
+
+	bidsReceived, err := productRepo.CountBidsReceived(j.UserID, since, j.Until)
+	if err != nil {
+		return err
+	}
+	bidsMade, err := productRepo.CountBidsMade(j.UserID, since, j.Until)
+	if err != nil {
+		return err
+	}
+	wins, err := productRepo.GetWonProducts(j.UserID, since, j.Until)
+	if err != nil {
+		return err
+	}
+	expiring, err := productRepo.GetClosingBidProducts(j.UserID, j.Until.Add(digestPeriod))
+	if err != nil {
+		return err
+	}
+
+	// tidak ada yang perlu dirangkum
+	if bidsReceived == 0 && bidsMade == 0 && len(wins) == 0 && len(expiring) == 0 {
+		return nil
+	}
+
+	h := NewNotifHandler()
+	title, message, err := RenderDigest(h.localeOf(j.UserID), TemplateData{
+		"bids_received": bidsReceived,
+		"bids_made":     bidsMade,
+		"wins":          wins,
+		"expiring":      expiring,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.sendTo(ChannelEmail, &Payload{ReceiverID: j.UserID, Title: title, Message: message})
+	if err == ErrNoRecipient {
+		return nil
+	}
+	return err
+}
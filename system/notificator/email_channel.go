@@ -0,0 +1,80 @@
+package notificator
+
+import (
+	"fmt"
+	"mime"
+	"net/smtp"
+	"os"
+	"strings"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+)
+
+// emailChannel mengirim notif melalui SMTP,
+// dikonfigurasi dengan SMTP_HOST, SMTP_PORT, SMTP_USERNAME, SMTP_PASSWORD dan SMTP_FROM
+type emailChannel struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     string
+}
+
+func newEmailChannel() *emailChannel {
+	port := os.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+
+	return &emailChannel{
+		host:     os.Getenv("SMTP_HOST"),
+		port:     port,
+		username: os.Getenv("SMTP_USERNAME"),
+		password: os.Getenv("SMTP_PASSWORD"),
+		from:     os.Getenv("SMTP_FROM"),
+	}
+}
+
+func (ch *emailChannel) Name() string {
+	return ChannelEmail
+}
+
+func (ch *emailChannel) Send(payload *Payload) error {
+	// tanpa SMTP tidak ada penerima yang bisa dihubungi, bukan error yang perlu dicoba ulang
+	if ch.host == "" {
+		return ErrNoRecipient
+	}
+
+	user, err := repository.NewUserRepository().GetByID(payload.ReceiverID)
+	if err != nil {
+		return err
+	} else if user.Email == "" {
+		return ErrNoRecipient
+	}
+
+	var auth smtp.Auth
+	if ch.username != "" {
+		auth = smtp.PlainAuth("", ch.username, ch.password, ch.host)
+	}
+
+	msg := strings.Join([]string{
+		fmt.Sprintf("From: %s", ch.from),
+		fmt.Sprintf("To: %s", user.Email),
+		fmt.Sprintf("Subject: %s", encodeHeader(payload.Title)),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=\"utf-8\"",
+		"",
+		payload.Message,
+	}, "\r\n")
+
+	return smtp.SendMail(ch.host+":"+ch.port, auth, ch.from, []string{user.Email}, []byte(msg))
+}
+
+// headerBreaks CR/LF pada nilai header bisa menyisipkan header lain
+var headerBreaks = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// encodeHeader nilai header dari input user (eg: nama user pada title notif),
+// CR/LF dibuang lalu diencode sebagai encoded-word agar karakter non-ascii tetap utuh
+func encodeHeader(value string) string {
+	return mime.QEncoding.Encode("utf-8", headerBreaks.Replace(value))
+}
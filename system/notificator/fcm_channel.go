@@ -0,0 +1,99 @@
+package notificator
+
+import (
+	"log"
+	"os"
+
+	"github.com/appleboy/go-fcm"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/utils"
+)
+
+// fcmMaxRecipients batas registration ids dalam satu request multicast FCM
+const fcmMaxRecipients = 1000
+
+// fcmChannel push notif melalui firebase cloud messaging
+type fcmChannel struct {
+	ServerKey string
+}
+
+func newFCMChannel() *fcmChannel {
+	return &fcmChannel{
+		ServerKey: os.Getenv("FCM_SERVER_KEY"),
+	}
+}
+
+func (ch *fcmChannel) Name() string {
+	return ChannelPush
+}
+
+// Send kirim ke semua device penerima, app id yang dilaporkan tidak valid oleh FCM dihapus
+func (ch *fcmChannel) Send(payload *Payload) error {
+	repo := repository.NewUserRepository()
+	conns, err := repo.GetUserConnects(payload.ReceiverID)
+	if err != nil {
+		return err
+	} else if len(conns) == 0 {
+		return ErrNoRecipient
+	}
+
+	appIDs := []string{}
+	for _, conn := range conns {
+		appIDs = append(appIDs, conn.AppID)
+	}
+
+	// Create a FCM client to send the message.
+	client, err := fcm.NewClient(ch.ServerKey)
+	if err != nil {
+		return err
+	}
+
+	data := utils.StructToMap(*payload)
+	for start := 0; start < len(appIDs); start += fcmMaxRecipients {
+		end := start + fcmMaxRecipients
+		if end > len(appIDs) {
+			end = len(appIDs)
+		}
+		batch := appIDs[start:end]
+
+		// Send the message and receive the response without retries.
+		response, err := client.Send(&fcm.Message{
+			RegistrationIDs: batch,
+			Data:            data,
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("NotifHandler] Response success: %d, failure: %d\n", response.Success, response.Failure)
+		ch.cleanup(repo, batch, response.Results)
+	}
+
+	return nil
+}
+
+// cleanup hapus app id yang sudah tidak terdaftar dan ganti app id yang punya canonical id,
+// urutan results sama dengan urutan registration ids yang dikirim
+func (ch *fcmChannel) cleanup(repo *repository.UserRepository, appIDs []string, results []fcm.Result) {
+	invalid := []string{}
+	for i, result := range results {
+		if i >= len(appIDs) {
+			break
+		}
+
+		if result.Unregistered() {
+			invalid = append(invalid, appIDs[i])
+		} else if result.RegistrationID != "" && result.RegistrationID != appIDs[i] {
+			if err := repo.ReplaceAppID(appIDs[i], result.RegistrationID); err != nil {
+				log.Printf("NotifHandler] Replace app id Error: %s", err.Error())
+			}
+		}
+	}
+
+	if len(invalid) > 0 {
+		log.Printf("NotifHandler] Pruning %d invalid app id", len(invalid))
+		if err := repo.PruneAppIDs(invalid...); err != nil {
+			log.Printf("NotifHandler] Prune app id Error: %s", err.Error())
+		}
+	}
+}
@@ -1,16 +1,25 @@
 package notificator
 
 import (
+	"fmt"
 	"log"
-	"os"
+	"strings"
 	"time"
 
-	"github.com/appleboy/go-fcm"
+	"github.com/fatkhur1960/goauction/app"
 	"github.com/fatkhur1960/goauction/app/repository"
-	"github.com/fatkhur1960/goauction/app/utils"
 	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
 )
 
+// defaultTimezone digunakan ketika timezone user tidak valid
+var defaultTimezone = time.FixedZone("WIB", 7*60*60)
+
+func init() {
+	queue.Register(&ChannelSendJob{})
+}
+
 // Payload for notificator
 type Payload struct {
 	NotifID     int64          `json:"notif_id"`
@@ -24,46 +33,212 @@ type Payload struct {
 	ClickAction string         `json:"click_action"`
 }
 
-// NotifHandler holder
+// ChannelSendJob mengirim payload ke satu channel, error dari channel dikembalikan
+// sehingga pengiriman dicoba ulang dengan backoff queue tanpa mengulang channel lain.
+// Fallback diantrikan ketika channel tidak punya penerima
+type ChannelSendJob struct {
+	Channel  string   `json:"channel"`
+	Fallback string   `json:"fallback"`
+	Payload  *Payload `json:"payload"`
+}
+
+// Handle --
+func (j *ChannelSendJob) Handle() error {
+	err := NewNotifHandler().sendTo(j.Channel, j.Payload)
+	if err != ErrNoRecipient {
+		return err
+	}
+
+	if j.Fallback == "" {
+		return nil
+	}
+	return queue.JobQueue.Push(&ChannelSendJob{Channel: j.Fallback, Payload: j.Payload})
+}
+
+// NotifHandler holder, mengirim payload ke channel sesuai preferensi penerima
 type NotifHandler struct {
-	ServerKey string
+	notifRepo *repository.NotifRepository
+	userRepo  *repository.UserRepository
 }
 
 // NewNotifHandler instance
 func NewNotifHandler() *NotifHandler {
 	return &NotifHandler{
-		ServerKey: os.Getenv("FCM_SERVER_KEY"),
+		notifRepo: repository.NewNotifRepository(),
+		userRepo:  repository.NewUserRepository(),
+	}
+}
+
+// Notify render notif dari catalog sesuai locale penerima, simpan ke inbox lalu kirim ke channel
+func (h *NotifHandler) Notify(receiverID int64, notifType core.NotifType, targetID int64, item interface{}, data TemplateData) error {
+	title, message, err := Render(notifType, h.localeOf(receiverID), data)
+	if err != nil {
+		return err
 	}
+
+	return h.deliver(receiverID, notifType, targetID, item, title, message)
+}
+
+// NotifyFrom seperti Notify untuk notif yang dipicu oleh actor,
+// notif tidak dikirim jika penerima memblokir actor
+func (h *NotifHandler) NotifyFrom(actorID int64, receiverID int64, notifType core.NotifType, targetID int64, item interface{}, data TemplateData) error {
+	if h.userRepo.HasBlocked(receiverID, actorID) {
+		return nil
+	}
+
+	return h.Notify(receiverID, notifType, targetID, item, data)
+}
+
+// deliver simpan notif yang sudah dirender ke inbox lalu kirim ke channel
+func (h *NotifHandler) deliver(receiverID int64, notifType core.NotifType, targetID int64, item interface{}, title string, message string) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		return h.deliverTx(tx, receiverID, notifType, targetID, item, title, message)
+	})
+}
+
+// deliverTx simpan notif ke inbox dan antrikan pengirimannya ke channel dalam transaksi yang sama,
+// notif tidak tersimpan jika job pengiriman gagal diantrikan
+func (h *NotifHandler) deliverTx(tx *gorm.DB, receiverID int64, notifType core.NotifType, targetID int64, item interface{}, title string, message string) error {
+	userNotif, err := h.notifRepo.CreateNotifTx(tx, receiverID, title, message, notifType, targetID)
+	if err != nil {
+		return err
+	}
+
+	return h.SendTx(tx, &Payload{
+		NotifID:    userNotif.ID,
+		ReceiverID: receiverID,
+		TargetID:   targetID,
+		NotifKind:  notifType,
+		Item:       item,
+		Title:      title,
+		Message:    message,
+		Created:    userNotif.CreatedAT,
+	})
+}
+
+// localeOf locale yang dipilih user, DefaultLocale jika user tidak ditemukan
+func (h *NotifHandler) localeOf(userID int64) string {
+	if user, err := h.userRepo.GetByID(userID); err == nil {
+		return user.Locale
+	}
+	return DefaultLocale
 }
 
 // Send notif with payload
-func (h *NotifHandler) Send(payload *Payload) {
-	repo := repository.NewUserRepository()
-	userConn, err := repo.GetAppID(payload.ReceiverID)
+func (h *NotifHandler) Send(payload *Payload) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		return h.SendTx(tx, payload)
+	})
+}
+
+// SendTx antrikan ChannelSendJob untuk setiap channel penerima menggunakan transaksi yang diberikan
+func (h *NotifHandler) SendTx(tx *gorm.DB, payload *Payload) error {
+	selected := h.channelsFor(payload)
+	quiet := h.inQuietHours(payload.ReceiverID, time.Now())
+
+	for _, name := range selected {
+		if quiet && mutedOnQuietHours[name] {
+			continue
+		}
+		if _, ok := getChannel(name); !ok {
+			log.Printf("NotifHandler] channel `%s` is not registered", name)
+			continue
+		}
+
+		// fallback hanya jika channel pengganti belum dipilih user
+		fallback := fallbacks[name]
+		if _, ok := getChannel(fallback); !ok || contains(selected, fallback) || (quiet && mutedOnQuietHours[fallback]) {
+			fallback = ""
+		}
+
+		err := queue.JobQueue.PushTx(tx, &ChannelSendJob{Channel: name, Fallback: fallback, Payload: payload})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *NotifHandler) sendTo(name string, payload *Payload) error {
+	ch, ok := getChannel(name)
+	if !ok {
+		log.Printf("NotifHandler] channel `%s` is not registered", name)
+		return fmt.Errorf("channel `%s` is not registered", name)
+	}
+
+	err := ch.Send(payload)
+	if err != nil && err != ErrNoRecipient {
+		log.Printf("NotifHandler] %s Error: %s", name, err.Error())
+	}
+	return err
+}
+
+// channelsFor channel yang dipilih penerima untuk jenis notif ini
+func (h *NotifHandler) channelsFor(payload *Payload) []string {
+	preference, err := h.notifRepo.GetPreference(payload.ReceiverID, payload.NotifKind)
 	if err != nil {
-		log.Printf("NotifHandler] AppID Error: %s", err.Error())
-		return
+		if !gorm.IsRecordNotFoundError(err) {
+			log.Printf("NotifHandler] Preference Error: %s", err.Error())
+		}
+		return DefaultChannels(payload.NotifKind)
+	}
+
+	selected := []string{}
+	for _, name := range strings.Split(preference.Channels, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			selected = append(selected, name)
+		}
 	}
+	return selected
+}
 
-	data := utils.StructToMap(payload)
-	msg := &fcm.Message{
-		To:   userConn.AppID,
-		Data: data,
+// inQuietHours cek apakah waktu sekarang berada dalam quiet hours penerima
+func (h *NotifHandler) inQuietHours(userID int64, now time.Time) bool {
+	setting, err := h.notifRepo.GetSetting(userID)
+	if err != nil || setting.QuietStart == "" || setting.QuietEnd == "" {
+		return false
 	}
 
-	// Create a FCM client to send the message.
-	client, clientErr := fcm.NewClient(h.ServerKey)
+	return InQuietHours(setting.QuietStart, setting.QuietEnd, setting.Timezone, now)
+}
+
+// InQuietHours cek apakah now berada di antara start dan end (format HH:MM) pada timezone,
+// rentang yang melewati tengah malam seperti 22:00-07:00 didukung
+func InQuietHours(start string, end string, timezone string, now time.Time) bool {
+	startMin, err1 := ParseClock(start)
+	endMin, err2 := ParseClock(end)
+	if err1 != nil || err2 != nil || startMin == endMin {
+		return false
+	}
+
+	loc, err := time.LoadLocation(timezone)
 	if err != nil {
-		log.Printf("NotifHandler] Client Error: %s", clientErr.Error())
-		return
+		loc = defaultTimezone
+	}
+	local := now.In(loc)
+	current := local.Hour()*60 + local.Minute()
+
+	if startMin < endMin {
+		return current >= startMin && current < endMin
 	}
+	return current >= startMin || current < endMin
+}
 
-	// Send the message and receive the response without retries.
-	response, err := client.Send(msg)
+// ParseClock mengubah HH:MM menjadi menit sejak tengah malam
+func ParseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
 	if err != nil {
-		log.Printf("NotifHandler] Send Error: %s", err.Error())
-		return
+		return 0, err
 	}
+	return t.Hour()*60 + t.Minute(), nil
+}
 
-	log.Printf("NotifHandler] Response %#v\n", response)
+func contains(list []string, item string) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,26 @@
+package notificator
+
+// SocketEmitter mengirim payload ke koneksi socket milik user,
+// mengembalikan false jika user sedang tidak terhubung
+type SocketEmitter func(userID int64, payload *Payload) bool
+
+// socketChannel notif in-app melalui socket.io
+type socketChannel struct {
+	emit SocketEmitter
+}
+
+// NewSocketChannel channel in-app, didaftarkan oleh socket server dengan RegisterChannel
+func NewSocketChannel(emit SocketEmitter) Channel {
+	return &socketChannel{emit: emit}
+}
+
+func (ch *socketChannel) Name() string {
+	return ChannelSocket
+}
+
+func (ch *socketChannel) Send(payload *Payload) error {
+	if !ch.emit(payload.ReceiverID, payload) {
+		return ErrNoRecipient
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package notificator
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"text/template"
+
+	"github.com/fatkhur1960/goauction/system/core"
+)
+
+// Locale yang didukung template notif
+const (
+	LocaleID = "id"
+	LocaleEN = "en"
+)
+
+// DefaultLocale digunakan ketika user belum memilih locale atau locale tidak dikenal
+const DefaultLocale = LocaleID
+
+// TemplateData parameter untuk merender template notif, eg: user, product, price
+type TemplateData map[string]interface{}
+
+// Template judul dan isi notif untuk satu notif type dan locale
+type Template struct {
+	Title   string
+	Message string
+}
+
+// catalog template notif berdasarkan notif type dan locale,
+// digunakan bersama oleh push, email dan notif in-app
+var catalog = map[core.NotifType]map[string]Template{
+	core.GotBidder: {
+		LocaleID: {
+			Title:   "Hai, {{.user}} ngebid produk Anda",
+			Message: "{{.user}} ngebid produk `{{.product}}` dengan harga {{price .price}}",
+		},
+		LocaleEN: {
+			Title:   "Hi, {{.user}} placed a bid on your product",
+			Message: "{{.user}} bid on `{{.product}}` for {{price .price}}",
+		},
+	},
+	core.GotMessage: {
+		LocaleID: {
+			Title:   "Pesan baru dari {{.user}}",
+			Message: "{{.message}}",
+		},
+		LocaleEN: {
+			Title:   "New message from {{.user}}",
+			Message: "{{.message}}",
+		},
+	},
+	core.BidClosed: {
+		LocaleID: {
+			Title:   "{{.product}} ditutup",
+			Message: "Belum ada pemenang untuk bid ini",
+		},
+		LocaleEN: {
+			Title:   "{{.product}} is closed",
+			Message: "There is no winner for this auction yet",
+		},
+	},
+	core.GotWinner: {
+		LocaleID: {
+			Title:   "{{.product}} ditutup",
+			Message: "{{.user}} memenangkan bid Anda dengan harga {{price .price}}",
+		},
+		LocaleEN: {
+			Title:   "{{.product}} is closed",
+			Message: "{{.user}} won your auction for {{price .price}}",
+		},
+	},
+	core.WinBid: {
+		LocaleID: {
+			Title:   "Selamat Anda menangkan bid untuk {{.product}}",
+			Message: "Anda memenangkan bid dengan harga {{price .price}}",
+		},
+		LocaleEN: {
+			Title:   "Congratulations, you won the auction for {{.product}}",
+			Message: "You won the auction for {{price .price}}",
+		},
+	},
+	core.Outbid: {
+		LocaleID: {
+			Title:   "Bid Anda untuk {{.product}} dilampaui",
+			Message: "{{.user}} menawar {{price .price}}, lebih tinggi dari bid Anda",
+		},
+		LocaleEN: {
+			Title:   "You have been outbid on {{.product}}",
+			Message: "{{.user}} bid {{price .price}}, higher than your bid",
+		},
+	},
+}
+
+// coalescedCatalog template untuk beberapa notif sejenis yang digabung menjadi satu,
+// data berisi count dan data dari notif terakhir
+var coalescedCatalog = map[core.NotifType]map[string]Template{
+	core.GotBidder: {
+		LocaleID: {
+			Title:   "{{.count}} bid baru untuk {{.product}}",
+			Message: "Bid tertinggi saat ini {{price .price}} dari {{.user}}",
+		},
+		LocaleEN: {
+			Title:   "{{.count}} new bids on {{.product}}",
+			Message: "The highest bid is now {{price .price}} by {{.user}}",
+		},
+	},
+	core.Outbid: {
+		LocaleID: {
+			Title:   "Bid Anda untuk {{.product}} dilampaui",
+			Message: "Ada {{.count}} bid baru, tertinggi {{price .price}}",
+		},
+		LocaleEN: {
+			Title:   "You have been outbid on {{.product}}",
+			Message: "There are {{.count}} new bids, the highest is {{price .price}}",
+		},
+	},
+}
+
+// digestCatalog template email ringkasan harian
+var digestCatalog = map[string]Template{
+	LocaleID: {
+		Title: "Ringkasan harian GoAuction",
+		Message: `Bid masuk untuk produk Anda: {{.bids_received}}
+Bid yang Anda buat: {{.bids_made}}
+{{range .wins}}Anda memenangkan {{.ProductName}}
+{{end}}{{range .expiring}}Lelang {{.ProductName}} yang Anda ikuti segera ditutup
+{{end}}`,
+	},
+	LocaleEN: {
+		Title: "Your GoAuction daily digest",
+		Message: `Bids on your products: {{.bids_received}}
+Bids you placed: {{.bids_made}}
+{{range .wins}}You won {{.ProductName}}
+{{end}}{{range .expiring}}The auction for {{.ProductName}} you are bidding on closes soon
+{{end}}`,
+	},
+}
+
+// ValidLocale cek apakah locale didukung template notif
+func ValidLocale(locale string) bool {
+	return locale == LocaleID || locale == LocaleEN
+}
+
+// Render judul dan isi notif sesuai notif type dan locale penerima,
+// locale yang tidak dikenal dirender dengan DefaultLocale
+func Render(notifType core.NotifType, locale string, data TemplateData) (string, string, error) {
+	if !ValidLocale(locale) {
+		locale = DefaultLocale
+	}
+
+	tmpl, ok := catalog[notifType][locale]
+	if !ok {
+		return "", "", fmt.Errorf("no template for notif type %d", notifType)
+	}
+	return render(tmpl, locale, data)
+}
+
+// RenderCoalesced render beberapa notif sejenis yang digabung, data harus berisi count
+func RenderCoalesced(notifType core.NotifType, locale string, data TemplateData) (string, string, error) {
+	if !ValidLocale(locale) {
+		locale = DefaultLocale
+	}
+
+	tmpl, ok := coalescedCatalog[notifType][locale]
+	if !ok {
+		return "", "", fmt.Errorf("no coalesced template for notif type %d", notifType)
+	}
+	return render(tmpl, locale, data)
+}
+
+// RenderDigest render email ringkasan harian
+func RenderDigest(locale string, data TemplateData) (string, string, error) {
+	if !ValidLocale(locale) {
+		locale = DefaultLocale
+	}
+	return render(digestCatalog[locale], locale, data)
+}
+
+func render(tmpl Template, locale string, data TemplateData) (string, string, error) {
+	funcs := template.FuncMap{
+		"price": func(amount interface{}) string {
+			return FormatPrice(locale, toFloat(amount))
+		},
+	}
+
+	title, err := execute(tmpl.Title, funcs, data)
+	if err != nil {
+		return "", "", err
+	}
+	message, err := execute(tmpl.Message, funcs, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return title, message, nil
+}
+
+func execute(text string, funcs template.FuncMap, data TemplateData) (string, error) {
+	t, err := template.New("notif").Funcs(funcs).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// FormatPrice format harga rupiah sesuai locale, eg: "Rp 1.250.000" untuk id dan "Rp 1,250,000" untuk en
+func FormatPrice(locale string, amount float64) string {
+	separator := "."
+	if locale == LocaleEN {
+		separator = ","
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	digits := fmt.Sprintf("%.0f", math.Round(amount))
+	groups := []string{}
+	for len(digits) > 3 {
+		groups = append([]string{digits[len(digits)-3:]}, groups...)
+		digits = digits[:len(digits)-3]
+	}
+	groups = append([]string{digits}, groups...)
+
+	return fmt.Sprintf("%sRp %s", sign, strings.Join(groups, separator))
+}
+
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case float32:
+		return float64(n)
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	default:
+		log.Printf("NotifHandler] price is not a number: %v", v)
+		return 0
+	}
+}
@@ -1,7 +1,13 @@
 package queue
 
-//JobQueue ... a buffered channel that we can send work requests on.
-var JobQueue chan Queuable
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+//JobQueue ... a persistent queue that we can send work requests on.
+var JobQueue = NewJobStore()
 
 //Queuable ... interface of Queuable Job
 type Queuable interface {
@@ -11,46 +17,53 @@ type Queuable interface {
 //Dispatcher ... worker dispatcher
 type Dispatcher struct {
 	maxWorkers int
-	WorkerPool chan chan Queuable
 	Workers    []Worker
+	scheduler  scheduler
+	running    sync.WaitGroup
+	once       sync.Once
 }
 
 //NewDispatcher ... creates new queue dispatcher
 func NewDispatcher(maxWorkers int) *Dispatcher {
-	// make job bucket
-	if JobQueue == nil {
-		JobQueue = make(chan Queuable, 10)
-	}
-	pool := make(chan chan Queuable, maxWorkers)
-	return &Dispatcher{WorkerPool: pool, maxWorkers: maxWorkers}
+	return &Dispatcher{maxWorkers: maxWorkers}
 }
 
 //Run ... starts work of dispatcher and creates the workers
 func (d *Dispatcher) Run() {
 	// starting n number of workers
 	for i := 0; i < d.maxWorkers; i++ {
-		worker := NewWorker(d.WorkerPool)
+		worker := NewWorker(JobQueue, &d.running)
 		worker.Start()
 		// register in dispatcher's workers
 		d.Workers = append(d.Workers, worker)
 	}
 
-	go d.dispatch()
+	d.scheduler = newScheduler(JobQueue, &d.running)
+	d.scheduler.Start()
 }
 
-func (d *Dispatcher) dispatch() {
-	for {
-		select {
-		case job := <-JobQueue:
-			// a job request has been received
-			go func(job Queuable) {
-				// try to obtain a worker job channel that is available.
-				// this will block until a worker is idle
-				jobChannel := <-d.WorkerPool
-
-				// dispatch the job to the worker job channel
-				jobChannel <- job
-			}(job)
+//Stop ... stops the workers and waits for the jobs in progress.
+// Jobs that have not been picked up stay in the job table for the next run.
+// Calling Stop again only waits for the jobs in progress.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	d.once.Do(func() {
+		for _, worker := range d.Workers {
+			worker.Stop()
 		}
+		d.scheduler.Stop()
+	})
+
+	finished := make(chan bool)
+	go func() {
+		d.running.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-ctx.Done():
+		log.Println("Dispatcher] deadline exceeded, unfinished jobs will be retried after lock timeout")
+		return ctx.Err()
 	}
 }
@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]reflect.Type{}
+)
+
+//Register ... registers job types so they can be stored and restored from the job table.
+// Jobs with pointer receivers must be registered as pointer, eg: Register(&MyJob{})
+func Register(jobs ...Queuable) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for _, job := range jobs {
+		t := reflect.TypeOf(job)
+		registry[typeName(t)] = t
+	}
+}
+
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.String()
+}
+
+func encode(job Queuable) (string, string, error) {
+	name := typeName(reflect.TypeOf(job))
+
+	registryMu.RLock()
+	_, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return "", "", fmt.Errorf("job type `%s` is not registered", name)
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		return "", "", err
+	}
+
+	return name, string(data), nil
+}
+
+func decode(name string, payload string) (Queuable, error) {
+	registryMu.RLock()
+	t, ok := registry[name]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("job type `%s` is not registered", name)
+	}
+
+	elem := t
+	if t.Kind() == reflect.Ptr {
+		elem = t.Elem()
+	}
+
+	value := reflect.New(elem)
+	if err := json.Unmarshal([]byte(payload), value.Interface()); err != nil {
+		return nil, err
+	}
+
+	if t.Kind() == reflect.Ptr {
+		return value.Interface().(Queuable), nil
+	}
+	return value.Elem().Interface().(Queuable), nil
+}
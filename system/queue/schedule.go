@@ -0,0 +1,148 @@
+package queue
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/jinzhu/gorm"
+	"github.com/robfig/cron/v3"
+)
+
+// scheduleInterval jeda pengecekan job_schedules yang sudah waktunya jalan
+const scheduleInterval = 30 * time.Second
+
+// dueSchedulesQuery mengunci schedule yang sudah jatuh tempo,
+// sehingga hanya satu instance yang meng-enqueue job-nya
+const dueSchedulesQuery = `
+SELECT * FROM job_schedules
+WHERE next_run_at <= ?
+ORDER BY next_run_at
+FOR UPDATE SKIP LOCKED`
+
+//Schedule ... registers a recurring job using standard cron spec, e.g. "0 7 * * *".
+// Specs are evaluated in UTC regardless of the server timezone, convert local times first
+// (07:00 WIB is "0 0 * * *"). Calling it again with the same name updates the spec and the payload.
+func (s *JobStore) Schedule(name, spec string, job Queuable) error {
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return fmt.Errorf("invalid cron spec %q: %s", spec, err.Error())
+	}
+
+	jobType, payload, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		nextRun := sched.Next(now)
+
+		row := models.JobSchedule{}
+		err := models.NewJobScheduleQuerySet(tx.Set("gorm:query_option", "FOR UPDATE")).
+			NameEq(name).One(&row)
+		if gorm.IsRecordNotFoundError(err) {
+			row = models.JobSchedule{
+				Name:      name,
+				Spec:      spec,
+				JobType:   jobType,
+				Payload:   payload,
+				NextRunAT: &nextRun,
+				CreatedAT: &now,
+			}
+			return row.Create(tx)
+		} else if err != nil {
+			return err
+		}
+
+		updater := models.NewJobScheduleQuerySet(tx).NameEq(name).GetUpdater().
+			SetJobType(jobType).
+			SetPayload(payload)
+		if row.Spec != spec {
+			updater = updater.SetSpec(spec).SetNextRunAT(&nextRun)
+		}
+		return updater.Update()
+	})
+}
+
+// enqueueDue pushes the jobs of every due schedule and moves them to the next run
+func (s *JobStore) enqueueDue() error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		schedules := []models.JobSchedule{}
+		if err := tx.Raw(dueSchedulesQuery, now).Scan(&schedules).Error; err != nil {
+			return err
+		}
+
+		for _, row := range schedules {
+			sched, err := cron.ParseStandard(row.Spec)
+			if err != nil {
+				log.Printf("Scheduler] Invalid spec for %s: %s\n", row.Name, err.Error())
+				continue
+			}
+
+			job, err := decode(row.JobType, row.Payload)
+			if err != nil {
+				log.Printf("Scheduler] Can't decode job %s: %s\n", row.Name, err.Error())
+				continue
+			}
+
+			// key per jadwal agar satu jadwal tidak pernah di-enqueue dua kali
+			key := fmt.Sprintf("schedule:%s:%d", row.Name, row.NextRunAT.Unix())
+			if err := s.PushTx(tx, job, Key(key)); err != nil {
+				return err
+			}
+
+			nextRun := sched.Next(now)
+			err = models.NewJobScheduleQuerySet(tx).NameEq(row.Name).GetUpdater().
+				SetNextRunAT(&nextRun).
+				SetLastRunAT(&now).
+				Update()
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// scheduler enqueues due recurring jobs until quit is closed
+type scheduler struct {
+	store   *JobStore
+	running *sync.WaitGroup
+	quit    chan bool
+}
+
+func newScheduler(store *JobStore, running *sync.WaitGroup) scheduler {
+	return scheduler{
+		store:   store,
+		running: running,
+		quit:    make(chan bool),
+	}
+}
+
+func (s scheduler) Start() {
+	s.running.Add(1)
+	go func() {
+		defer s.running.Done()
+		for {
+			if err := s.store.enqueueDue(); err != nil {
+				log.Println("Scheduler] Got Error:", err.Error())
+			}
+
+			select {
+			case <-s.quit:
+				return
+			case <-time.After(scheduleInterval):
+			}
+		}
+	}()
+}
+
+func (s scheduler) Stop() {
+	close(s.quit)
+}
@@ -0,0 +1,222 @@
+package queue
+
+import (
+	"math"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// DefaultMaxAttempts jumlah percobaan sebelum job dipindah ke dead_jobs
+	DefaultMaxAttempts = 5
+	// lockTimeout job yang terkunci lebih lama dari ini dianggap worker-nya mati
+	lockTimeout = 5 * time.Minute
+	baseBackoff = 10 * time.Second
+	maxBackoff  = time.Hour
+)
+
+// claimQuery mengambil satu job yang siap dijalankan dan menguncinya,
+// job yang sedang dikunci transaksi lain dilewati (SKIP LOCKED).
+// Job dalam satu group baru bisa diambil setelah job sebelumnya selesai atau dipindah ke dead_jobs.
+const claimQuery = `
+UPDATE jobs SET locked_at = ?, attempts = attempts + 1
+WHERE id = (
+	SELECT id FROM jobs j
+	WHERE run_at <= ? AND (locked_at IS NULL OR locked_at < ?)
+	AND (group_key IS NULL OR NOT EXISTS (
+		SELECT 1 FROM jobs prev WHERE prev.group_key = j.group_key AND prev.id < j.id
+	))
+	ORDER BY run_at, id
+	FOR UPDATE SKIP LOCKED
+	LIMIT 1
+)
+RETURNING *`
+
+// upsertQuery menggantikan job dengan unique_key yang sama selama belum dikerjakan worker,
+// tidak ada row yang berubah jika job tersebut sedang dikunci worker
+const upsertQuery = `
+INSERT INTO jobs (job_type, payload, max_attempts, run_at, unique_key, group_key, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT (unique_key) DO UPDATE
+SET job_type = EXCLUDED.job_type, payload = EXCLUDED.payload, run_at = EXCLUDED.run_at
+WHERE jobs.locked_at IS NULL`
+
+// Attempter can be implemented by a job to override DefaultMaxAttempts
+type Attempter interface {
+	MaxAttempts() int
+}
+
+// Option for pushing a job
+type Option func(job *models.Job)
+
+// RunAt delays the job until the given time
+func RunAt(t time.Time) Option {
+	return func(job *models.Job) {
+		runAt := t.UTC()
+		job.RunAT = &runAt
+	}
+}
+
+// Delay delays the job for the given duration
+func Delay(d time.Duration) Option {
+	return RunAt(time.Now().UTC().Add(d))
+}
+
+// Key makes the job unique, pushing a job with the same key replaces the pending one.
+// When that job is already running the new one runs after it as a follow-up
+func Key(key string) Option {
+	return func(job *models.Job) {
+		job.UniqueKey = &key
+	}
+}
+
+// Group makes the jobs with the same group key run one at a time in push order
+func Group(key string) Option {
+	return func(job *models.Job) {
+		job.GroupKey = &key
+	}
+}
+
+//JobStore ... postgres backed job queue
+type JobStore struct {
+	wake chan bool
+}
+
+//NewJobStore ... creates new job store
+func NewJobStore() *JobStore {
+	return &JobStore{wake: make(chan bool, 1)}
+}
+
+//Push ... stores the job so it will be picked up by a worker
+func (s *JobStore) Push(job Queuable, opts ...Option) error {
+	return s.PushTx(app.DB, job, opts...)
+}
+
+//PushTx ... stores the job using the given transaction
+func (s *JobStore) PushTx(tx *gorm.DB, job Queuable, opts ...Option) error {
+	name, payload, err := encode(job)
+	if err != nil {
+		return err
+	}
+
+	maxAttempts := DefaultMaxAttempts
+	if a, ok := job.(Attempter); ok {
+		maxAttempts = a.MaxAttempts()
+	}
+
+	now := time.Now().UTC()
+	row := models.Job{
+		JobType:     name,
+		Payload:     payload,
+		MaxAttempts: maxAttempts,
+		RunAT:       &now,
+		CreatedAT:   &now,
+	}
+	for _, opt := range opts {
+		opt(&row)
+	}
+
+	if row.UniqueKey != nil {
+		err = upsert(tx, &row)
+	} else {
+		err = row.Create(tx)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.notify()
+	return nil
+}
+
+// upsert replaces the pending job with the same unique key. A job that is being run by a worker
+// can't be replaced, so the new one is stored as a follow-up under `<key>:next` instead of being dropped
+func upsert(tx *gorm.DB, row *models.Job) error {
+	for {
+		res := tx.Exec(upsertQuery, row.JobType, row.Payload, row.MaxAttempts, row.RunAT, row.UniqueKey, row.GroupKey, row.CreatedAT)
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.Error
+		}
+
+		next := *row.UniqueKey + ":next"
+		row.UniqueKey = &next
+	}
+}
+
+// notify wakes up an idle worker without blocking
+func (s *JobStore) notify() {
+	select {
+	case s.wake <- true:
+	default:
+	}
+}
+
+// claim locks the next runnable job, returns nil when there is nothing to do
+func (s *JobStore) claim() (*models.Job, error) {
+	job := models.Job{}
+	now := time.Now().UTC()
+	err := app.DB.Raw(claimQuery, now, now, now.Add(-lockTimeout)).Scan(&job).Error
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// complete removes the finished job
+func (s *JobStore) complete(job *models.Job) error {
+	return models.NewJobQuerySet(app.DB).IDEq(job.ID).Delete()
+}
+
+// fail schedules a retry with exponential backoff or moves the job to dead_jobs
+func (s *JobStore) fail(job *models.Job, jobErr error) error {
+	if job.Attempts >= job.MaxAttempts {
+		return s.bury(job, jobErr)
+	}
+
+	runAt := time.Now().UTC().Add(backoff(job.Attempts))
+	return models.NewJobQuerySet(app.DB).IDEq(job.ID).GetUpdater().
+		SetRunAT(&runAt).
+		SetLockedAT(nil).
+		SetLastError(jobErr.Error()).
+		Update()
+}
+
+// bury moves the job to the dead-letter table
+func (s *JobStore) bury(job *models.Job, jobErr error) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		now := time.Now().UTC()
+		dead := models.DeadJob{
+			ID:        job.ID,
+			JobType:   job.JobType,
+			Payload:   job.Payload,
+			Attempts:  job.Attempts,
+			LastError: jobErr.Error(),
+			CreatedAT: job.CreatedAT,
+			FailedAT:  &now,
+		}
+		if err := dead.Create(tx); err != nil {
+			return err
+		}
+
+		return models.NewJobQuerySet(tx).IDEq(job.ID).Delete()
+	})
+}
+
+// backoff returns 10s, 20s, 40s, ... capped at maxBackoff
+func backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	d := time.Duration(float64(baseBackoff) * math.Pow(2, float64(attempts-1)))
+	if d > maxBackoff || d <= 0 {
+		return maxBackoff
+	}
+	return d
+}
@@ -2,42 +2,94 @@ package queue
 
 import (
 	"log"
-	"reflect"
+	"sync"
+	"time"
 
+	"github.com/fatkhur1960/goauction/app/models"
 	"github.com/gin-gonic/gin"
 )
 
+// pollInterval jeda pengecekan job ketika antrian kosong
+const pollInterval = 2 * time.Second
+
 //Worker … simple worker that handles queueable tasks
 type Worker struct {
-	Name       string
-	WorkerPool chan chan Queuable
-	JobChannel chan Queuable
-	quit       chan bool
+	Name    string
+	store   *JobStore
+	running *sync.WaitGroup
+	quit    chan bool
 }
 
 // NewWorker --
-func NewWorker(workerPool chan chan Queuable) Worker {
-	job := make(chan Queuable, 10)
-	return Worker{WorkerPool: workerPool, JobChannel: job}
+func NewWorker(store *JobStore, running *sync.WaitGroup) Worker {
+	return Worker{
+		store:   store,
+		running: running,
+		quit:    make(chan bool),
+	}
 }
 
 //Start ... initiate worker to start listening for upcoming queueable jobs
 func (w Worker) Start() {
+	w.running.Add(1)
 	go func() {
+		defer w.running.Done()
 		for {
-			// register the current worker into the worker queue.
-			w.WorkerPool <- w.JobChannel
-
 			select {
-			case job := <-w.JobChannel:
-				if gin.IsDebugging() {
-					log.Println("Worker] Got Event:", reflect.TypeOf(job).String())
-				}
-				// we have received a work request.
-				if err := job.Handle(); err != nil {
-					log.Println("Worker] Got Error:", err.Error())
+			case <-w.quit:
+				return
+			default:
+			}
+
+			job, err := w.store.claim()
+			if err != nil {
+				log.Println("Worker] Claim Error:", err.Error())
+			}
+
+			if job == nil {
+				select {
+				case <-w.quit:
+					return
+				case <-w.store.wake:
+				case <-time.After(pollInterval):
 				}
+				continue
 			}
+
+			w.process(job)
 		}
 	}()
 }
+
+//Stop ... signals the worker to stop after the current job
+func (w Worker) Stop() {
+	close(w.quit)
+}
+
+func (w Worker) process(row *models.Job) {
+	job, err := decode(row.JobType, row.Payload)
+	if err != nil {
+		log.Printf("Worker] Can't decode job #%d: %s\n", row.ID, err.Error())
+		if err := w.store.bury(row, err); err != nil {
+			log.Println("Worker] Got Error:", err.Error())
+		}
+		return
+	}
+
+	if gin.IsDebugging() {
+		log.Println("Worker] Got Event:", row.JobType)
+	}
+
+	// we have received a work request.
+	if err := job.Handle(); err != nil {
+		log.Printf("Worker] Job #%d attempt %d/%d got error: %s\n", row.ID, row.Attempts, row.MaxAttempts, err.Error())
+		if err := w.store.fail(row, err); err != nil {
+			log.Println("Worker] Got Error:", err.Error())
+		}
+		return
+	}
+
+	if err := w.store.complete(row); err != nil {
+		log.Println("Worker] Got Error:", err.Error())
+	}
+}
@@ -0,0 +1,59 @@
+package socket
+
+import (
+	"log"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/cluster"
+)
+
+// userConnected catat koneksi baru, lawan chat diberi tahu saat koneksi pertama user di semua instance
+func userConnected(hub *cluster.Cluster, userID int64) {
+	first, err := hub.Connected(userID)
+	if err != nil {
+		log.Printf("WS] Update presence user #%d error: %s\n", userID, err.Error())
+		return
+	}
+
+	if first {
+		broadcastPresence(hub, chat.Presence{UserID: userID, Online: true})
+	}
+}
+
+// userDisconnected catat koneksi yang ditutup, saat koneksi terakhir user di semua instance ditutup
+// last seen disimpan lalu lawan chat diberi tahu
+func userDisconnected(hub *cluster.Cluster, userID int64) {
+	last, err := hub.Disconnected(userID)
+	if err != nil {
+		log.Printf("WS] Update presence user #%d error: %s\n", userID, err.Error())
+		return
+	}
+
+	if !last {
+		return
+	}
+
+	now := time.Now().UTC()
+	if err := repository.NewUserRepository().SetLastSeen(userID, now); err != nil {
+		log.Printf("WS] Update last seen user #%d error: %s\n", userID, err.Error())
+	}
+	broadcastPresence(hub, chat.Presence{UserID: userID, LastSeenAT: &now})
+}
+
+// broadcastPresence kirim perubahan presence ke semua lawan chat user yang sedang online
+func broadcastPresence(hub *cluster.Cluster, presence chat.Presence) {
+	partnerIDs, err := repository.NewChatRepository().GetChatPartnerIDs(presence.UserID)
+	if err == nil {
+		partnerIDs, err = hub.OnlineUsers(partnerIDs)
+	}
+	if err != nil {
+		log.Printf("WS] Get chat partners of user #%d error: %s\n", presence.UserID, err.Error())
+		return
+	}
+
+	for _, partnerID := range partnerIDs {
+		broadcast(hub, "/", userRoom(partnerID), "presence", presence)
+	}
+}
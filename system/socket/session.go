@@ -0,0 +1,125 @@
+package socket
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/middleware"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/cluster"
+	socketio "github.com/googollee/go-socket.io"
+)
+
+// revalidateInterval jeda pengecekan ulang token semua koneksi,
+// koneksi dengan token yang sudah dicabut atau expired akan diputus
+const revalidateInterval = 30 * time.Second
+
+// errUnauthenticated koneksi tanpa token yang valid
+var errUnauthenticated = errors.New("unauthenticated")
+
+// Session identitas user untuk satu koneksi socket,
+// dibagi oleh semua namespace dalam koneksi yang sama
+type Session struct {
+	User  models.User
+	Token string
+	conn  socketio.Conn
+}
+
+var (
+	sessionsMu sync.RWMutex
+	// sessions berdasarkan engine session id
+	sessions = map[string]*Session{}
+)
+
+// authenticate validasi token dari query `token` atau header Authorization,
+// session disimpan di context koneksi
+func authenticate(s socketio.Conn) (*Session, error) {
+	url := s.URL()
+	token := url.Query().Get("token")
+	if token == "" {
+		token = strings.TrimPrefix(s.RemoteHeader().Get("Authorization"), "Bearer ")
+	}
+	if token == "" {
+		return nil, errUnauthenticated
+	}
+
+	user, err := middleware.Authenticate(token)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &Session{User: user, Token: token, conn: s}
+	sessionsMu.Lock()
+	sessions[s.ID()] = session
+	sessionsMu.Unlock()
+
+	s.SetContext(session)
+	return session, nil
+}
+
+// bindSession pasang session koneksi ke namespace selain root,
+// context socket.io disimpan per namespace sehingga perlu dipasang ulang
+func bindSession(s socketio.Conn) (*Session, error) {
+	sessionsMu.RLock()
+	session, ok := sessions[s.ID()]
+	sessionsMu.RUnlock()
+	if !ok {
+		return nil, errUnauthenticated
+	}
+
+	s.SetContext(session)
+	return session, nil
+}
+
+// sessionOf session milik koneksi, nil jika koneksi belum terautentikasi
+func sessionOf(s socketio.Conn) *Session {
+	session, _ := s.Context().(*Session)
+	return session
+}
+
+// removeSession hapus session koneksi, nil jika session sudah dihapus sebelumnya
+func removeSession(s socketio.Conn) *Session {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+
+	session, ok := sessions[s.ID()]
+	if !ok {
+		return nil
+	}
+	delete(sessions, s.ID())
+	return session
+}
+
+// closeSession hapus session koneksi dan perbarui presence user-nya,
+// aman dipanggil lebih dari sekali untuk koneksi yang sama
+func closeSession(hub *cluster.Cluster, s socketio.Conn) {
+	if session := removeSession(s); session != nil {
+		userDisconnected(hub, session.User.ID)
+	}
+}
+
+// revalidateSessions putus koneksi yang token-nya sudah dicabut (unauthorize) atau expired
+func revalidateSessions(hub *cluster.Cluster) {
+	ticker := time.NewTicker(revalidateInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		sessionsMu.RLock()
+		current := make([]*Session, 0, len(sessions))
+		for _, session := range sessions {
+			current = append(current, session)
+		}
+		sessionsMu.RUnlock()
+
+		for _, session := range current {
+			if _, err := middleware.Authenticate(session.Token); err != nil {
+				log.Printf("WS] Dropping connection of %s: %s\n", session.User.FullName, err.Error())
+				closeSession(hub, session.conn)
+				session.conn.Close()
+			}
+		}
+	}
+}
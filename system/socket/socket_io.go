@@ -3,78 +3,284 @@ package socket
 import (
 	"fmt"
 	"log"
-	"time"
 
-	"github.com/fatkhur1960/goauction/app/middleware"
 	"github.com/fatkhur1960/goauction/app/models"
 	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/auction"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/cluster"
+	"github.com/fatkhur1960/goauction/system/notificator"
 	socketio "github.com/googollee/go-socket.io"
 )
 
 type join struct {
-	FullName string `json:"full_name"`
-	RoomName string `json:"room_name"`
+	ChatID int64 `json:"chat_id"`
 }
 
-type message struct {
-	ID         int64     `json:"id"`
-	Room       string    `json:"room"`
-	SenderID   int64     `json:"sender_id"`
-	ReceiverID int64     `json:"receiver_id"`
-	Text       string    `json:"text"`
-	Ts         time.Time `json:"ts"`
-}
-
-func (msg *message) toReplyMsg() *messageReply {
-	userRepo := repository.NewUserRepository()
-	return &messageReply{
-		ID:       msg.ID,
-		Room:     msg.Room,
-		Sender:   userRepo.UserSimple(msg.SenderID),
-		Receiver: userRepo.UserSimple(msg.ReceiverID),
-		Ts:       msg.Ts,
-	}
+// subscribe request live stream lelang product,
+// last_event_id diisi id event terakhir yang diterima untuk resume setelah reconnect
+type subscribe struct {
+	ProductID   int64 `json:"product_id"`
+	LastEventID int64 `json:"last_event_id"`
+}
+
+// typing status mengetik participant, tidak disimpan
+type typing struct {
+	ChatID int64 `json:"chat_id"`
+	UserID int64 `json:"user_id"`
+	Typing bool  `json:"typing"`
+}
+
+// read read receipt dari client, message_id 0 berarti sampai message terakhir
+type read struct {
+	ChatID    int64 `json:"chat_id"`
+	MessageID int64 `json:"message_id"`
+}
+
+// edit ubah text message milik pengirim
+type edit struct {
+	MessageID int64  `json:"message_id"`
+	Text      string `json:"text"`
 }
 
-type messageReply struct {
-	ID       int64              `json:"id"`
-	Room     string             `json:"room"`
-	Sender   *models.UserSimple `json:"sender"`
-	Receiver *models.UserSimple `json:"receiver"`
-	Text     string             `json:"text"`
-	Ts       time.Time          `json:"ts"`
+// remove hapus message, for_everyone hanya untuk pengirim
+type remove struct {
+	MessageID   int64 `json:"message_id"`
+	ForEveryone bool  `json:"for_everyone"`
 }
 
+// message dikirim client melalui event `send`, sender diambil dari session koneksi
+type message struct {
+	ChatID       int64  `json:"chat_id"`
+	Text         string `json:"text"`
+	AttachmentID int64  `json:"attachment_id"`
+}
+
+// hub node cluster instance ini
+var hub *cluster.Cluster
+
 // Handler websocket function
 func Handler() *socketio.Server {
 	server, err := socketio.NewServer(nil)
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	// broadcast ke room selalu melalui hub agar sampai ke koneksi di instance lain
+	hub = cluster.New(func(msg cluster.Message) {
+		server.BroadcastToRoom(msg.Namespace, msg.Room, msg.Event, msg.Args)
+		notifyWatchers(msg.Room)
+	})
+	if err := hub.Start(); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("WS] Joined cluster as node %s\n", hub.NodeID)
 	server.OnConnect("/", func(s socketio.Conn) error {
-		s.SetContext("")
-		log.Printf("WS] Connected: %s\n", middleware.CurrentUser.FullName)
+		session, err := authenticate(s)
+		if err != nil {
+			// error dari OnConnect diabaikan oleh go-socket.io, koneksi harus ditutup sendiri
+			log.Printf("WS] Rejected connection %s: %s\n", s.RemoteAddr(), err.Error())
+			s.Close()
+			return err
+		}
+
+		log.Printf("WS] Connected: %s\n", session.User.FullName)
+		// setiap koneksi join ke room user-nya agar bisa menerima notif in-app
+		s.Join(userRoom(session.User.ID))
+		userConnected(hub, session.User.ID)
+		return nil
+	})
+	server.OnConnect("/chat", func(s socketio.Conn) error {
+		if _, err := bindSession(s); err != nil {
+			s.Close()
+			return err
+		}
 		return nil
 	})
 	server.OnEvent("/chat", "join", func(s socketio.Conn, join join) {
-		log.Printf("WS] %s joined on chat id %s\n", join.FullName, join.RoomName)
-		s.Join(join.RoomName)
+		session := sessionOf(s)
+		if session == nil || !repository.NewChatRepository().IsChatParticipant(join.ChatID, session.User.ID) {
+			s.Emit("error", "Anda tidak memiliki akses ke chat ini")
+			return
+		}
+
+		log.Printf("WS] %s joined on chat id %d\n", session.User.FullName, join.ChatID)
+		s.Join(chatRoom(join.ChatID))
 	})
 	server.OnEvent("/chat", "send", func(s socketio.Conn, msg message) {
-		log.Printf("WS] got messsage: %s\n", msg.Text)
-		server.BroadcastToRoom("/", msg.Room, "reply", msg.toReplyMsg())
+		session := sessionOf(s)
+		if session == nil {
+			return
+		}
+
+		// reply dikirim ke room oleh transport, termasuk ke pengirim
+		_, err := chat.Send(session.User.ID, repository.ChatMessageQuery{
+			ChatID:       msg.ChatID,
+			Text:         msg.Text,
+			AttachmentID: msg.AttachmentID,
+		})
+		if err != nil {
+			s.Emit("error", err.Error())
+		}
+	})
+	server.OnEvent("/chat", "edit", func(s socketio.Conn, e edit) {
+		session := sessionOf(s)
+		if session == nil {
+			return
+		}
+
+		if _, err := chat.Edit(session.User.ID, e.MessageID, e.Text); err != nil {
+			s.Emit("error", err.Error())
+		}
+	})
+	server.OnEvent("/chat", "delete", func(s socketio.Conn, r remove) {
+		session := sessionOf(s)
+		if session == nil {
+			return
+		}
+
+		if err := chat.Delete(session.User.ID, r.MessageID, r.ForEveryone); err != nil {
+			s.Emit("error", err.Error())
+		}
+	})
+	server.OnEvent("/chat", "typing", func(s socketio.Conn, t typing) {
+		session := sessionOf(s)
+		if session == nil || !joined(s, chatRoom(t.ChatID)) {
+			return
+		}
+
+		t.UserID = session.User.ID
+		broadcast(hub, "/chat", chatRoom(t.ChatID), "typing", t)
+	})
+	server.OnEvent("/chat", "read", func(s socketio.Conn, r read) {
+		session := sessionOf(s)
+		if session == nil {
+			return
+		}
+
+		if _, err := chat.MarkRead(session.User.ID, r.ChatID, r.MessageID); err != nil {
+			s.Emit("error", err.Error())
+		}
 	})
 	server.OnEvent("/chat", "leave", func(s socketio.Conn, join join) {
-		log.Printf("WS] %s leave from %s\n", join.FullName, join.RoomName)
-		s.Leave(join.RoomName)
+		s.Leave(chatRoom(join.ChatID))
+	})
+	server.OnConnect("/auction", func(s socketio.Conn) error {
+		if _, err := bindSession(s); err != nil {
+			s.Close()
+			return err
+		}
+		return nil
+	})
+	server.OnEvent("/auction", "subscribe", func(s socketio.Conn, sub subscribe) {
+		// join lebih dulu agar tidak ada event yang terlewat selama replay,
+		// event yang diterima dua kali bisa dikenali client dari id-nya
+		s.Join(productRoom(sub.ProductID))
+		if sub.LastEventID <= 0 {
+			return
+		}
+
+		messages, err := auction.Replay(sub.ProductID, sub.LastEventID)
+		if err != nil {
+			log.Printf("WS] Replay auction #%d error: %s\n", sub.ProductID, err.Error())
+			s.Emit("error", "Tidak dapat memuat event lelang")
+			return
+		}
+		for _, msg := range messages {
+			s.Emit(msg.Type, msg)
+		}
+	})
+	server.OnEvent("/auction", "unsubscribe", func(s socketio.Conn, sub subscribe) {
+		s.Leave(productRoom(sub.ProductID))
 	})
 	server.OnError("/", func(s socketio.Conn, e error) {
 		log.Println("WS] meet error:", e)
 	})
 	server.OnDisconnect("/", func(s socketio.Conn, reason string) {
 		fmt.Println("WS] closed", reason)
-		server.LeaveAllRooms("/chat", s)
+		closeSession(hub, s)
+	})
+
+	go revalidateSessions(hub)
+
+	notificator.RegisterChannel(notificator.NewSocketChannel(func(userID int64, payload *notificator.Payload) bool {
+		if !hub.Online(userID) {
+			return false
+		}
+		return broadcast(hub, "/", userRoom(userID), "notif", payload)
+	}))
+
+	chat.SetTransport(&chatTransport{hub})
+	auction.SetBroadcaster(func(msg *auction.Message) {
+		broadcast(hub, "/auction", productRoom(msg.ProductID), msg.Type, msg)
 	})
 
 	return server
 }
+
+// StopCluster keluar dari cluster, dipanggil setelah socket server ditutup
+func StopCluster() {
+	if hub != nil {
+		hub.Stop()
+	}
+}
+
+// broadcast kirim event ke room di semua instance
+func broadcast(hub *cluster.Cluster, namespace, room, event string, args interface{}) bool {
+	if err := hub.Broadcast(namespace, room, event, args); err != nil {
+		log.Printf("WS] Broadcast %s to %s error: %s\n", event, room, err.Error())
+		return false
+	}
+	return true
+}
+
+// productRoom room berisi koneksi yang mengikuti live stream lelang product
+func productRoom(productID int64) string {
+	return fmt.Sprintf("product:%d", productID)
+}
+
+// chatRoom room berisi koneksi participant chat
+func chatRoom(chatID int64) string {
+	return fmt.Sprintf("chat:%d", chatID)
+}
+
+// joined cek apakah koneksi sudah join ke room
+func joined(s socketio.Conn, room string) bool {
+	for _, r := range s.Rooms() {
+		if r == room {
+			return true
+		}
+	}
+	return false
+}
+
+// userRoom room berisi semua koneksi milik user
+func userRoom(userID int64) string {
+	return fmt.Sprintf("user:%d", userID)
+}
+
+// chatTransport kirim message chat melalui namespace /chat
+type chatTransport struct {
+	hub *cluster.Cluster
+}
+
+// Push kirim reply ke room chat dan ke room user penerima, sehingga penerima yang online
+// namun belum join ke room chat tetap menerima message. Client mengenali reply ganda dari id-nya
+func (t *chatTransport) Push(reply *chat.Reply) {
+	broadcast(t.hub, "/chat", chatRoom(reply.ChatID), "reply", reply)
+	if reply.Receiver != nil {
+		broadcast(t.hub, "/", userRoom(reply.Receiver.ID), "reply", reply)
+	}
+}
+
+func (t *chatTransport) PushUpdate(reply *chat.Reply) {
+	broadcast(t.hub, "/chat", chatRoom(reply.ChatID), "update", reply)
+}
+
+func (t *chatTransport) PushRead(read models.ChatRead) {
+	broadcast(t.hub, "/chat", chatRoom(read.ChatID), "read", read)
+}
+
+func (t *chatTransport) Online(userID int64) bool {
+	return t.hub.Online(userID)
+}
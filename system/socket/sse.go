@@ -0,0 +1,229 @@
+package socket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/auction"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/notificator"
+)
+
+// StreamTimeout samakan dengan WriteTimeout http.Server, stream ditutup sebelum batas ini
+// lalu client (EventSource) reconnect dengan header Last-Event-ID
+var StreamTimeout = 15 * time.Second
+
+const (
+	// streamMargin jeda antara stream ditutup dan WriteTimeout
+	streamMargin = 3 * time.Second
+	// streamHeartbeat jeda keepalive, sekaligus pengecekan event yang tidak membangunkan watcher
+	streamHeartbeat = 5 * time.Second
+	// streamRetry jeda reconnect client dalam milidetik
+	streamRetry = 1000
+	// streamBatch jumlah event maksimal per sumber untuk setiap pengambilan
+	streamBatch = 100
+	// MaxStreamProducts jumlah product yang bisa diikuti dalam satu stream
+	MaxStreamProducts = 20
+)
+
+// ErrStreamUnsupported response writer tidak mendukung flush
+var ErrStreamUnsupported = errors.New("Streaming tidak didukung")
+
+// cursor posisi terakhir setiap sumber event, dikirim sebagai id event dengan format `notif-message-auction`
+type cursor struct {
+	Notif   int64
+	Message int64
+	Auction int64
+}
+
+func (c cursor) String() string {
+	return fmt.Sprintf("%d-%d-%d", c.Notif, c.Message, c.Auction)
+}
+
+func parseCursor(id string) (cursor, bool) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 {
+		return cursor{}, false
+	}
+
+	values := [3]int64{}
+	for i, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || v < 0 {
+			return cursor{}, false
+		}
+		values[i] = v
+	}
+	return cursor{Notif: values[0], Message: values[1], Auction: values[2]}, true
+}
+
+// latestCursor cursor pada event terbaru, digunakan stream yang tidak resume
+func latestCursor() (cursor, error) {
+	var cur cursor
+	var err error
+	if cur.Notif, err = repository.NewNotifRepository().LastNotifID(); err != nil {
+		return cur, err
+	}
+	if cur.Message, err = repository.NewChatRepository().LastMessageID(); err != nil {
+		return cur, err
+	}
+	cur.Auction, err = repository.NewAuctionRepository().LastAuctionEventID()
+	return cur, err
+}
+
+// eventStream satu koneksi SSE milik user
+type eventStream struct {
+	w          http.ResponseWriter
+	flusher    http.Flusher
+	user       models.User
+	productIDs []int64
+	cur        cursor
+	watcher    *watcher
+}
+
+// ServeEvents kirim event yang sama dengan socket dalam format text/event-stream:
+// notif user, message chat dan event lelang product yang diikuti.
+// Stream dilanjutkan dari header Last-Event-ID (atau query last_event_id),
+// tanpa id stream dimulai dari event terbaru. Stream ditutup sebelum StreamTimeout
+func ServeEvents(w http.ResponseWriter, r *http.Request, user models.User, productIDs []int64) error {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return ErrStreamUnsupported
+	}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	cur, ok := parseCursor(lastEventID)
+	if !ok {
+		var err error
+		if cur, err = latestCursor(); err != nil {
+			return err
+		}
+	}
+
+	chatIDs, err := repository.NewChatRepository().GetUserChatIDs(user.ID)
+	if err != nil {
+		return err
+	}
+	rooms := []string{userRoom(user.ID)}
+	for _, chatID := range chatIDs {
+		rooms = append(rooms, chatRoom(chatID))
+	}
+	for _, productID := range productIDs {
+		rooms = append(rooms, productRoom(productID))
+	}
+	watcher := watch(rooms)
+	defer watcher.close()
+
+	header := w.Header()
+	header.Set("Content-Type", "text/event-stream")
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	header.Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s := &eventStream{w: w, flusher: flusher, user: user, productIDs: productIDs, cur: cur, watcher: watcher}
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", streamRetry); err != nil {
+		return nil
+	}
+	if err := s.sendPending(); err != nil {
+		return nil
+	}
+
+	deadline := time.NewTimer(StreamTimeout - streamMargin)
+	defer deadline.Stop()
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	// error tulis berarti client sudah putus, tidak perlu dilaporkan
+	for {
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-deadline.C:
+			return nil
+		case <-watcher.wake:
+			if err := s.sendPending(); err != nil {
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+				return nil
+			}
+			if err := s.sendPending(); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// sendPending kirim semua event setelah cursor lalu flush
+func (s *eventStream) sendPending() error {
+	notifs, err := repository.NewNotifRepository().GetUserNotifsAfter(s.user.ID, s.cur.Notif, streamBatch)
+	if err != nil {
+		return err
+	}
+	for _, notif := range notifs {
+		s.cur.Notif = notif.ID
+		err := s.send("notif", &notificator.Payload{
+			NotifID:    notif.ID,
+			ReceiverID: notif.UserID,
+			TargetID:   int64(notif.Target),
+			NotifKind:  core.NotifType(notif.NotifType),
+			Title:      notif.Title,
+			Message:    notif.Content,
+			Created:    notif.CreatedAT,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	messages, err := repository.NewChatRepository().GetUserMessagesAfter(s.user.ID, s.cur.Message, streamBatch)
+	if err != nil {
+		return err
+	}
+	for _, message := range messages {
+		s.cur.Message = message.ID
+		// chat room yang dibuat setelah stream dibuka, message pertamanya sampai melalui room user
+		// atau heartbeat, message berikutnya langsung membangunkan stream
+		s.watcher.add(chatRoom(message.ChatID))
+		if err := s.send("reply", chat.ToReply(message)); err != nil {
+			return err
+		}
+	}
+
+	events, err := auction.ReplayProducts(s.productIDs, s.cur.Auction, streamBatch)
+	if err != nil {
+		return err
+	}
+	for _, msg := range events {
+		s.cur.Auction = msg.ID
+		if err := s.send(msg.Type, msg); err != nil {
+			return err
+		}
+	}
+
+	s.flusher.Flush()
+	return nil
+}
+
+func (s *eventStream) send(event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(s.w, "id: %s\nevent: %s\ndata: %s\n\n", s.cur, event, payload)
+	return err
+}
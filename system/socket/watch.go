@@ -0,0 +1,66 @@
+package socket
+
+import "sync"
+
+// watcher menerima sinyal setiap ada broadcast ke salah satu room-nya,
+// digunakan oleh transport lain (eg: SSE) yang membaca event dari database
+type watcher struct {
+	rooms []string
+	wake  chan bool
+}
+
+var (
+	watchersMu sync.Mutex
+	watchers   = map[string]map[*watcher]bool{}
+)
+
+func watch(rooms []string) *watcher {
+	w := &watcher{rooms: rooms, wake: make(chan bool, 1)}
+
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	for _, room := range rooms {
+		if watchers[room] == nil {
+			watchers[room] = map[*watcher]bool{}
+		}
+		watchers[room][w] = true
+	}
+	return w
+}
+
+// add daftarkan watcher ke room baru, eg: chat room yang dibuat setelah stream dibuka
+func (w *watcher) add(room string) {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	if watchers[room][w] {
+		return
+	}
+	if watchers[room] == nil {
+		watchers[room] = map[*watcher]bool{}
+	}
+	watchers[room][w] = true
+	w.rooms = append(w.rooms, room)
+}
+
+func (w *watcher) close() {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	for _, room := range w.rooms {
+		delete(watchers[room], w)
+		if len(watchers[room]) == 0 {
+			delete(watchers, room)
+		}
+	}
+}
+
+// notifyWatchers bangunkan semua watcher room tanpa blocking
+func notifyWatchers(room string) {
+	watchersMu.Lock()
+	defer watchersMu.Unlock()
+	for w := range watchers[room] {
+		select {
+		case w.wake <- true:
+		default:
+		}
+	}
+}
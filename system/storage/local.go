@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// errInvalidKey key keluar dari direktori root
+var errInvalidKey = errors.New("Key object tidak valid")
+
+// LocalStorage simpan object sebagai file di bawah direktori Root
+type LocalStorage struct {
+	Root string
+}
+
+// NewLocalStorage creates new local storage
+func NewLocalStorage(root string) *LocalStorage {
+	return &LocalStorage{Root: root}
+}
+
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || strings.Contains(key, "..") {
+		return "", errInvalidKey
+	}
+	return filepath.Join(s.Root, filepath.FromSlash(clean)), nil
+}
+
+// Put simpan object ke file sementara lalu rename agar tidak ada file setengah jadi
+func (s *LocalStorage) Put(key string, body io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	tmp, err := os.OpenFile(path+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get buka file object
+func (s *LocalStorage) Get(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+	return file, err
+}
+
+// Delete hapus file object
+func (s *LocalStorage) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
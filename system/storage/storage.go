@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrObjectNotFound object dengan key tersebut tidak ada
+var ErrObjectNotFound = errors.New("Object tidak ditemukan")
+
+// Storage penyimpanan object berdasarkan key, mengikuti operasi dasar S3
+// (PutObject, GetObject, DeleteObject) sehingga storage S3-compatible
+// cukup mengimplementasikan interface ini lalu didaftarkan dengan SetDefault
+type Storage interface {
+	// Put simpan object, object dengan key yang sama akan ditimpa
+	Put(key string, body io.Reader, size int64, contentType string) error
+	// Get buka object untuk dibaca, ErrObjectNotFound jika tidak ada
+	Get(key string) (io.ReadCloser, error)
+	// Delete hapus object, tidak error jika object tidak ada
+	Delete(key string) error
+}
+
+var (
+	defaultMu      sync.Mutex
+	defaultStorage Storage
+)
+
+// Default storage yang digunakan aplikasi, dipilih dari env STORAGE_DRIVER
+// (saat ini hanya `local` dengan direktori STORAGE_PATH, default ./storage)
+func Default() Storage {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	if defaultStorage == nil {
+		driver := os.Getenv("STORAGE_DRIVER")
+		if driver != "" && driver != "local" {
+			log.Printf("Storage] unknown driver %s, using local storage\n", driver)
+		}
+
+		root := os.Getenv("STORAGE_PATH")
+		if root == "" {
+			root = "./storage"
+		}
+		defaultStorage = NewLocalStorage(root)
+	}
+	return defaultStorage
+}
+
+// SetDefault ganti storage yang digunakan aplikasi, eg: storage S3-compatible
+func SetDefault(s Storage) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultStorage = s
+}
+
+// secret kunci signature url, default ACCESS_SECRET jika STORAGE_SECRET kosong
+func secret() []byte {
+	if key := os.Getenv("STORAGE_SECRET"); key != "" {
+		return []byte(key)
+	}
+	return []byte(os.Getenv("ACCESS_SECRET"))
+}
+
+// Sign signature untuk resource yang berlaku sampai expires
+func Sign(resource string, expires time.Time) string {
+	mac := hmac.New(sha256.New, secret())
+	fmt.Fprintf(mac, "%s:%d", resource, expires.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify cek signature resource dan masa berlakunya
+func Verify(resource string, expires int64, signature string) bool {
+	if time.Now().Unix() > expires {
+		return false
+	}
+	expected := Sign(resource, time.Unix(expires, 0))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// RandomName nama object acak agar key tidak bisa ditebak
+func RandomName() string {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,197 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	// MaxDeliveryAttempts jumlah percobaan sebelum delivery dianggap gagal,
+	// jeda antar percobaan mengikuti backoff queue
+	MaxDeliveryAttempts = 6
+	// DisableAfterFailures webhook dinonaktifkan setelah sekian delivery gagal berturut-turut
+	DisableAfterFailures = 5
+	deliveryTimeout      = 10 * time.Second
+)
+
+// client dipakai untuk semua delivery, tanpa proxy agar ip yang dicek dialControl adalah ip receiver
+var client = &http.Client{
+	Timeout: deliveryTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: deliveryTimeout,
+			Control: dialControl,
+		}).DialContext,
+		TLSHandshakeTimeout: deliveryTimeout,
+	},
+}
+
+func init() {
+	queue.Register(&DeliverJob{})
+}
+
+// DeliverJob mengirim satu webhook delivery
+type DeliverJob struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
+
+// MaxAttempts percobaan dihitung sendiri di webhook_deliveries
+func (j *DeliverJob) MaxAttempts() int {
+	return MaxDeliveryAttempts
+}
+
+// Handle --
+func (j *DeliverJob) Handle() error {
+	delivery := models.WebhookDelivery{}
+	err := models.NewWebhookDeliveryQuerySet(app.DB).IDEq(j.DeliveryID).One(&delivery)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if delivery.Status != models.DeliveryPending {
+		return nil
+	}
+
+	hook := models.StoreWebhook{}
+	err = models.NewStoreWebhookQuerySet(app.DB).IDEq(delivery.WebhookID).One(&hook)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !hook.Active {
+		return finishDelivery(&hook, &delivery, models.DeliveryFailed, 0, "webhook is disabled")
+	}
+
+	statusCode, duration, sendErr := send(&hook, &delivery)
+	delivery.Attempts++
+
+	errMessage := ""
+	if sendErr != nil {
+		errMessage = sendErr.Error()
+	}
+
+	attempt := models.WebhookDeliveryAttempt{
+		DeliveryID: delivery.ID,
+		StatusCode: statusCode,
+		Error:      errMessage,
+		DurationMs: int64(duration / time.Millisecond),
+		CreatedAT:  timeNow(),
+	}
+	if err := attempt.Create(app.DB); err != nil {
+		log.Printf("Webhook] Can't log attempt for delivery #%d: %s\n", delivery.ID, err.Error())
+	}
+
+	if sendErr == nil {
+		return finishDelivery(&hook, &delivery, models.DeliverySuccess, statusCode, "")
+	}
+
+	if delivery.Attempts >= MaxDeliveryAttempts {
+		return finishDelivery(&hook, &delivery, models.DeliveryFailed, statusCode, errMessage)
+	}
+
+	err = models.NewWebhookDeliveryQuerySet(app.DB).IDEq(delivery.ID).GetUpdater().
+		SetAttempts(delivery.Attempts).
+		SetLastStatusCode(statusCode).
+		SetLastError(errMessage).
+		Update()
+	if err != nil {
+		return err
+	}
+
+	// dikembalikan ke queue agar dicoba lagi dengan backoff
+	return sendErr
+}
+
+// send POST payload ke url webhook, status 2xx dianggap berhasil
+func send(hook *models.StoreWebhook, delivery *models.WebhookDelivery) (int, time.Duration, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"delivery_id": delivery.ID,
+		"event":       delivery.EventType,
+		"created_at":  delivery.CreatedAT,
+		"data":        json.RawMessage(delivery.Payload),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Goauction-Webhook/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(hook.Secret, timestamp, body))
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	duration := time.Since(start)
+	if err != nil {
+		return 0, duration, err
+	}
+	defer resp.Body.Close()
+
+	// response body tidak disimpan karena error delivery ditampilkan ke pemilik webhook
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, duration, fmt.Errorf("receiver responded %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, duration, nil
+}
+
+// finishDelivery menyimpan status akhir delivery dan menghitung kegagalan berturut-turut webhook
+func finishDelivery(hook *models.StoreWebhook, delivery *models.WebhookDelivery, status int, statusCode int, errMessage string) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		updater := models.NewWebhookDeliveryQuerySet(tx).IDEq(delivery.ID).GetUpdater().
+			SetStatus(status).
+			SetAttempts(delivery.Attempts).
+			SetLastStatusCode(statusCode).
+			SetLastError(errMessage)
+		if status == models.DeliverySuccess {
+			updater = updater.SetDeliveredAT(timeNow())
+		}
+		if err := updater.Update(); err != nil {
+			return err
+		}
+
+		if status == models.DeliverySuccess {
+			return models.NewStoreWebhookQuerySet(tx).IDEq(hook.ID).GetUpdater().
+				SetFailureCount(0).
+				Update()
+		} else if !hook.Active {
+			return nil
+		}
+
+		failures := hook.FailureCount + 1
+		hookUpdater := models.NewStoreWebhookQuerySet(tx).IDEq(hook.ID).GetUpdater().SetFailureCount(failures)
+		if failures >= DisableAfterFailures {
+			log.Printf("Webhook] Disabling webhook #%d after %d failed deliveries\n", hook.ID, failures)
+			hookUpdater = hookUpdater.SetActive(false).SetDisabledAT(timeNow())
+		}
+		return hookUpdater.Update()
+	})
+}
+
+func timeNow() *time.Time {
+	now := time.Now().UTC()
+	return &now
+}
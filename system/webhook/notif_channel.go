@@ -0,0 +1,31 @@
+package webhook
+
+import (
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/jinzhu/gorm"
+)
+
+func init() {
+	notificator.RegisterChannel(&notifChannel{})
+}
+
+// notifChannel meneruskan notif pemilik store ke webhook store yang berlangganan event `notification`
+type notifChannel struct{}
+
+func (ch *notifChannel) Name() string {
+	return notificator.ChannelWebhook
+}
+
+func (ch *notifChannel) Send(payload *notificator.Payload) error {
+	store := models.Store{}
+	err := models.NewStoreQuerySet(app.DB).OwnerIDEq(payload.ReceiverID).One(&store)
+	if gorm.IsRecordNotFoundError(err) {
+		return notificator.ErrNoRecipient
+	} else if err != nil {
+		return err
+	}
+
+	return Enqueue(store.ID, Notification, payload)
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrInvalidURL url webhook bukan http atau https
+	ErrInvalidURL = errors.New("URL tidak valid")
+	// ErrPrivateTarget url webhook mengarah ke jaringan internal
+	ErrPrivateTarget = errors.New("URL webhook tidak boleh mengarah ke jaringan internal")
+)
+
+// AllowPrivateTargets izinkan webhook ke jaringan internal, hanya untuk testing dengan receiver lokal
+var AllowPrivateTargets = false
+
+// privateNets range ip internal yang tidak boleh dituju webhook, loopback, link-local
+// (termasuk metadata service 169.254.169.254) dan multicast dicek terpisah
+var privateNets = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.0.0.0/24",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"fc00::/7",
+)
+
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	nets := []*net.IPNet{}
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
+
+// privateIP cek apakah ip berada di jaringan internal
+func privateIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return true
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateURL cek url webhook saat didaftarkan, host di-resolve dan ditolak jika salah satu
+// ip-nya internal. Host yang gagal di-resolve diterima, ip tujuan tetap dicek lagi saat dial
+func ValidateURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+	if AllowPrivateTargets {
+		return nil
+	}
+
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		if privateIP(ip) {
+			return ErrPrivateTarget
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if privateIP(addr.IP) {
+			return ErrPrivateTarget
+		}
+	}
+	return nil
+}
+
+// dialControl tolak koneksi ke ip internal. Dicek pada ip yang benar-benar didial sehingga
+// tidak bisa diakali dengan dns rebinding maupun redirect ke alamat internal
+func dialControl(network string, address string, c syscall.RawConn) error {
+	if AllowPrivateTargets {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || privateIP(ip) {
+		return ErrPrivateTarget
+	}
+	return nil
+}
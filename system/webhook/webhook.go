@@ -0,0 +1,190 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/event"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/jinzhu/gorm"
+)
+
+// Event types yang bisa dipilih oleh webhook subscription
+const (
+	BidCreated    = "bid.created"
+	AuctionClosed = "auction.closed"
+	AuctionWon    = "auction.won"
+	ProductSold   = "product.sold"
+	Notification  = "notification"
+)
+
+// EventTypes semua event type yang didukung
+var EventTypes = []string{BidCreated, AuctionClosed, AuctionWon, ProductSold, Notification}
+
+// Header yang dikirim bersama payload webhook
+const (
+	HeaderEvent     = "X-Goauction-Event"
+	HeaderDelivery  = "X-Goauction-Delivery"
+	HeaderTimestamp = "X-Goauction-Timestamp"
+	HeaderSignature = "X-Goauction-Signature"
+)
+
+func init() {
+	event.DefaultBus.Subscribe(&event.UserBidProductEvent{}, "webhook.user_bid_product", onUserBidProduct)
+	event.DefaultBus.Subscribe(&event.ProductClosedEvent{}, "webhook.product_closed", onProductClosed)
+	event.DefaultBus.Subscribe(&event.ProductSoldEvent{}, "webhook.product_sold", onProductSold)
+}
+
+// ValidEventType --
+func ValidEventType(eventType string) bool {
+	for _, t := range EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateSecret membuat secret acak untuk signing payload
+func GenerateSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Sign menghasilkan signature `sha256=<hex>` dari HMAC-SHA256 atas "<timestamp>.<body>",
+// receiver memverifikasi dengan menghitung ulang menggunakan secret yang sama
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify cek signature yang diterima receiver
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// Enqueue membuat delivery untuk setiap webhook aktif milik store yang berlangganan eventType
+func Enqueue(storeID int64, eventType string, data interface{}) error {
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		return EnqueueTx(tx, storeID, eventType, data)
+	})
+}
+
+// EnqueueTx seperti Enqueue menggunakan transaksi yang diberikan, semua delivery dibuat atau tidak sama sekali
+// sehingga subscriber yang dicoba ulang tidak menggandakan delivery untuk webhook yang sudah berhasil
+func EnqueueTx(tx *gorm.DB, storeID int64, eventType string, data interface{}) error {
+	webhooks := []models.StoreWebhook{}
+	err := models.NewStoreWebhookQuerySet(tx).
+		StoreIDEq(storeID).
+		ActiveEq(true).
+		All(&webhooks)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	for _, hook := range webhooks {
+		if !hook.Subscribed(eventType) {
+			continue
+		}
+
+		if _, err := createDelivery(tx, hook.ID, eventType, string(payload)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Redeliver mengirim ulang payload dari delivery sebelumnya sebagai delivery baru,
+// sehingga log delivery sebelumnya tetap utuh
+func Redeliver(delivery *models.WebhookDelivery) (models.WebhookDelivery, error) {
+	redelivery := models.WebhookDelivery{}
+	err := app.DB.Transaction(func(tx *gorm.DB) (err error) {
+		redelivery, err = createDelivery(tx, delivery.WebhookID, delivery.EventType, delivery.Payload)
+		return err
+	})
+
+	return redelivery, err
+}
+
+func createDelivery(tx *gorm.DB, webhookID int64, eventType string, payload string) (models.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	delivery := models.WebhookDelivery{
+		WebhookID: webhookID,
+		EventType: eventType,
+		Payload:   payload,
+		Status:    models.DeliveryPending,
+		CreatedAT: &now,
+	}
+
+	if err := delivery.Create(tx); err != nil {
+		return delivery, err
+	}
+
+	// delivery untuk webhook yang sama dikirim berurutan
+	err := queue.JobQueue.PushTx(tx,
+		&DeliverJob{DeliveryID: delivery.ID},
+		queue.Group(fmt.Sprintf("webhook:%d", webhookID)),
+	)
+	return delivery, err
+}
+
+func onUserBidProduct(ev interface{}) error {
+	e := ev.(*event.UserBidProductEvent)
+	return Enqueue(e.Product.StoreID, BidCreated, map[string]interface{}{
+		"product_id":   e.Product.ID,
+		"product_name": e.Product.ProductName,
+		"bid_id":       e.BidData.ID,
+		"bidder_id":    e.BidData.UserID,
+		"bid_price":    e.BidData.BidPrice,
+		"created_at":   e.BidData.CreatedAT,
+	})
+}
+
+func onProductClosed(ev interface{}) error {
+	e := ev.(*event.ProductClosedEvent)
+	data := map[string]interface{}{
+		"product_id":    e.Product.ID,
+		"product_name":  e.Product.ProductName,
+		"closed_at":     e.Product.ClosedAT,
+		"winner_id":     e.WinnerID,
+		"winning_price": e.WinningPrice,
+	}
+
+	// kedua event dibuat dalam satu transaksi agar subscriber yang dicoba ulang tidak menggandakan auction.closed
+	return app.DB.Transaction(func(tx *gorm.DB) error {
+		if err := EnqueueTx(tx, e.Product.StoreID, AuctionClosed, data); err != nil {
+			return err
+		}
+
+		if e.WinnerID != nil {
+			return EnqueueTx(tx, e.Product.StoreID, AuctionWon, data)
+		}
+		return nil
+	})
+}
+
+func onProductSold(ev interface{}) error {
+	e := ev.(*event.ProductSoldEvent)
+	return Enqueue(e.Product.StoreID, ProductSold, map[string]interface{}{
+		"product_id":   e.Product.ID,
+		"product_name": e.Product.ProductName,
+	})
+}
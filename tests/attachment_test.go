@@ -0,0 +1,126 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/storage"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+var pngData = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 64)...)
+
+// useTempStorage simpan attachment test di direktori sementara
+func useTempStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "goauction-storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	storage.SetDefault(storage.NewLocalStorage(dir))
+	t.Cleanup(func() { os.RemoveAll(dir) })
+}
+
+func uploadAttachment(token string, chatID int64, fileName string, data []byte) app.Result {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	writer.WriteField("chat_id", fmt.Sprintf("%d", chatID))
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(data)
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf("%s/api%s", ts.URL, endpoint.UploadAttachment), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+
+	return parseResult(resp, err)
+}
+
+func toLink(result interface{}) chat.AttachmentLink {
+	link := chat.AttachmentLink{}
+	data, _ := json.Marshal(result)
+	json.Unmarshal(data, &link)
+	return link
+}
+
+func TestUploadAttachment(t *testing.T) {
+	useTempStorage(t)
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+
+	rv := uploadAttachment(senderToken, room.ID, "foto.png", pngData)
+	assert.Equal(t, 0, rv.Code)
+	link := toLink(rv.Result)
+	assert.Equal(t, "image/png", link.ContentType)
+	assert.Equal(t, chat.AttachmentImage, link.Kind)
+
+	rv = reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, AttachmentID: link.ID}, senderToken)
+	assert.Equal(t, 0, rv.Code)
+	reply := rv.Result.(map[string]interface{})
+	assert.Equal(t, float64(chat.AttachmentImage), reply["attachment_kind"])
+
+	// participant lain bisa mendapatkan url lalu download
+	rv = reqGET(fmt.Sprintf("%s?attachment_id=%d", endpoint.AttachmentURL, link.ID), receiverToken)
+	assert.Equal(t, 0, rv.Code)
+	resp, err := http.Get(ts.URL + toLink(rv.Result).URL)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	data, _ := ioutil.ReadAll(resp.Body)
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	assert.Equal(t, pngData, data)
+}
+
+func TestUploadAttachmentValidation(t *testing.T) {
+	useTempStorage(t)
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, _, _ := generateUserThenActivate()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+
+	// content type dideteksi dari isi file, bukan dari nama file
+	rv := uploadAttachment(senderToken, room.ID, "foto.png", []byte("bukan gambar"))
+	assert.Equal(t, chat.ErrAttachmentType.Error(), rv.Description)
+
+	rv = uploadAttachment(senderToken, room.ID, "besar.pdf", append([]byte("%PDF-1.4\n"), make([]byte, chat.MaxAttachmentSize)...))
+	assert.NotEqual(t, 0, rv.Code)
+
+	rv = uploadAttachment(authorizeUser(), room.ID, "foto.png", pngData)
+	assert.Equal(t, chat.ErrNotParticipant.Error(), rv.Description)
+
+	rv = uploadAttachment(senderToken, room.ID, "dokumen.pdf", []byte("%PDF-1.4\n%%EOF"))
+	assert.Equal(t, 0, rv.Code)
+	assert.Equal(t, chat.AttachmentDocument, toLink(rv.Result).Kind)
+}
+
+func TestAttachmentAccess(t *testing.T) {
+	useTempStorage(t)
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+	link := toLink(uploadAttachment(senderToken, room.ID, "foto.png", pngData).Result)
+
+	strangerID, strangerToken := authorizeUserWithID()
+	rv := reqGET(fmt.Sprintf("%s?attachment_id=%d", endpoint.AttachmentURL, link.ID), strangerToken)
+	assert.NotEqual(t, 0, rv.Code)
+
+	// attachment hanya bisa dikirim oleh uploader ke chat room tempat file diupload
+	rv = reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, AttachmentID: link.ID}, receiverToken)
+	assert.Equal(t, chat.ErrAttachmentNotFound.Error(), rv.Description)
+	otherRoom, _ := chatRepo.CreateChat(senderID, strangerID)
+	rv = reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: otherRoom.ID, AttachmentID: link.ID}, senderToken)
+	assert.Equal(t, chat.ErrAttachmentNotFound.Error(), rv.Description)
+
+	rv = reqGET(fmt.Sprintf("%s?id=%d&expires=%d&sig=%s", endpoint.DownloadAttachment, link.ID, link.ExpiresAT.Unix(), "invalid"), "")
+	assert.Equal(t, chat.ErrInvalidSignature.Error(), rv.Description)
+}
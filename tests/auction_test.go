@@ -0,0 +1,37 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/fatkhur1960/goauction/system/auction"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestAuctionStreamResume(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	product, _ := createProduct(token, store.ID)
+
+	broadcasted := []*auction.Message{}
+	auction.SetBroadcaster(func(msg *auction.Message) {
+		broadcasted = append(broadcasted, msg)
+	})
+	defer auction.SetBroadcaster(nil)
+
+	err := auction.Publish(product.ID, auction.Entry{Type: auction.EventBidPlaced, Data: map[string]interface{}{"bid_price": 100000}})
+	assert.Equal(t, err, nil)
+	err = auction.Publish(product.ID,
+		auction.Entry{Type: auction.EventAuctionClosed, Data: map[string]interface{}{}},
+		auction.Entry{Type: auction.EventWinner, Data: map[string]interface{}{"winning_price": 100000}},
+	)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(broadcasted), 3)
+
+	// client yang reconnect setelah menerima event pertama
+	missed, err := auction.Replay(product.ID, broadcasted[0].ID)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, len(missed), 2)
+	assert.Equal(t, missed[0].Type, auction.EventAuctionClosed)
+	assert.Equal(t, missed[1].Type, auction.EventWinner)
+	assert.Equal(t, missed[1].ID, broadcasted[2].ID)
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"testing"
 
+	"github.com/fatkhur1960/goauction/app/middleware"
 	"github.com/fatkhur1960/goauction/app/service"
 	"github.com/fatkhur1960/goauction/tests/endpoint"
 	"github.com/go-playground/assert/v2"
@@ -31,3 +32,15 @@ func TestUnauthorizeUser(t *testing.T) {
 	rv1 := reqGET(endpoint.MeInfo, token)
 	assert.Equal(t, rv1.Code, 4010)
 }
+
+func TestAuthenticateRevokedToken(t *testing.T) {
+	userID, token := authorizeUserWithID()
+
+	user, err := middleware.Authenticate(token)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, user.ID, userID)
+
+	reqPOST(endpoint.UnauthorizeUser, nil, token)
+	_, err = middleware.Authenticate(token)
+	assert.NotEqual(t, err, nil)
+}
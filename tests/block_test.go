@@ -0,0 +1,103 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/service"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockAndUnblockUser(t *testing.T) {
+	token := authorizeUser()
+	userID, _, _ := generateUserThenActivate()
+
+	rv := reqPOST(endpoint.BlockUser, service.BlockUserQuery{UserID: userID}, token)
+	assert.Equal(t, rv.Code, 0)
+	// block ulang tidak error
+	rv = reqPOST(endpoint.BlockUser, service.BlockUserQuery{UserID: userID}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqGET(endpoint.ListBlockedUsers+"?offset=0&limit=10", token)
+	entries := struct {
+		Entries []service.BlockedUser `json:"entries"`
+		Count   int                   `json:"count"`
+	}{}
+	mapToJSON(rv.Result.(map[string]interface{}), &entries)
+	assert.Equal(t, entries.Count, 1)
+	assert.Equal(t, entries.Entries[0].User.ID, userID)
+
+	rv = reqPOST(endpoint.UnblockUser, service.BlockUserQuery{UserID: userID}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqGET(endpoint.ListBlockedUsers+"?offset=0&limit=10", token)
+	mapToJSON(rv.Result.(map[string]interface{}), &entries)
+	assert.Equal(t, entries.Count, 0)
+}
+
+func TestBlockSelf(t *testing.T) {
+	userID, token := authorizeUserWithID()
+
+	rv := reqPOST(endpoint.BlockUser, service.BlockUserQuery{UserID: userID}, token)
+	assert.Equal(t, rv.Description, "Tidak dapat memblokir diri sendiri")
+}
+
+func TestCreateChatRoomBlocked(t *testing.T) {
+	blockerID, blockerToken := authorizeUserWithID()
+	blockedID, token := authorizeUserWithID()
+
+	reqPOST(endpoint.BlockUser, service.BlockUserQuery{UserID: blockedID}, blockerToken)
+
+	// kedua arah ditolak
+	rv := reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: blockerID}, token)
+	assert.Equal(t, rv.Code, 4030)
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: blockedID}, blockerToken)
+	assert.Equal(t, rv.Code, 4030)
+}
+
+func TestSendMessageBlocked(t *testing.T) {
+	senderID, token := authorizeUserWithID()
+	receiverID, _, _ := generateUserThenActivate()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	repository.NewUserRepository().BlockUser(receiverID, senderID)
+
+	rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{
+		ChatID: room.ID,
+		Text:   "Halo?",
+	}, token)
+	assert.Equal(t, rv.Code, 4030)
+	assert.Equal(t, rv.Description, chat.ErrBlocked.Error())
+}
+
+func TestBidProductBlocked(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	product, _ := createProduct(token, store.ID)
+	bidderID, bidderToken := authorizeUserWithID()
+	repository.NewUserRepository().BlockUser(store.OwnerID, bidderID)
+
+	rv := reqPOST(endpoint.BidProduct, service.BidProductQuery{
+		ProductID: product.ID,
+		BidPrice:  50000,
+	}, bidderToken)
+	assert.Equal(t, rv.Code, 4030)
+}
+
+func TestNotifyFromBlockedActor(t *testing.T) {
+	receiverID, _, _ := generateUserThenActivate()
+	actorID, _, _ := generateUserThenActivate()
+	repository.NewUserRepository().BlockUser(receiverID, actorID)
+
+	handler := notificator.NewNotifHandler()
+	err := handler.NotifyFrom(actorID, receiverID, core.GotMessage, 1, nil, notificator.TemplateData{
+		"user": "Budi", "message": "Halo",
+	})
+	assert.Equal(t, err, nil)
+
+	notifs, _ := repository.NewNotifRepository().GetUserNotifs(receiverID, repository.NotifFilter{}, 0, 10)
+	assert.Equal(t, len(notifs), 0)
+}
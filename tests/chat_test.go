@@ -1,9 +1,18 @@
 package test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
 	"github.com/fatkhur1960/goauction/app/service"
+	"github.com/fatkhur1960/goauction/app/types"
+	"github.com/fatkhur1960/goauction/system/chat"
+	"github.com/fatkhur1960/goauction/system/core"
 	"github.com/fatkhur1960/goauction/tests/endpoint"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,3 +53,358 @@ func TestListChatRooms(t *testing.T) {
 	mapToJSON(rv.Result.(map[string]interface{}), &entries)
 	assert.NotEqual(t, 0, entries.Count)
 }
+
+func TestIsChatParticipant(t *testing.T) {
+	initiatorID, _, _ := generateUserThenActivate()
+	subscriberID, _, _ := generateUserThenActivate()
+	strangerID, _, _ := generateUserThenActivate()
+	chatRepo := repository.NewChatRepository()
+	chat, _ := chatRepo.CreateChat(initiatorID, subscriberID)
+
+	assert.Equal(t, chatRepo.IsChatParticipant(chat.ID, initiatorID), true)
+	assert.Equal(t, chatRepo.IsChatParticipant(chat.ID, subscriberID), true)
+	assert.Equal(t, chatRepo.IsChatParticipant(chat.ID, strangerID), false)
+}
+
+func TestSendMessage(t *testing.T) {
+	senderID, token := authorizeUserWithID()
+	receiverID, _, _ := generateUserThenActivate()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+
+	rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{
+		ChatID: room.ID,
+		Text:   "Barang masih ada?",
+	}, token)
+	assert.Equal(t, 0, rv.Code)
+
+	reply := chat.Reply{}
+	mapToJSON(rv.Result.(map[string]interface{}), &reply)
+	assert.Equal(t, "Barang masih ada?", reply.Text)
+	assert.Equal(t, receiverID, reply.Receiver.ID)
+
+	messages, count, _ := chatRepo.GetChatMessages(room.ID, receiverID, 0, 10)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, senderID, messages[0].SenderID)
+
+	// receiver tidak punya koneksi socket, notif harus terkirim
+	notifs, _ := repository.NewNotifRepository().GetUserNotifs(receiverID, repository.NotifFilter{}, 0, 10)
+	assert.Equal(t, 1, len(notifs))
+	assert.Equal(t, int(core.GotMessage), notifs[0].NotifType)
+}
+
+func TestSendMessageNotParticipant(t *testing.T) {
+	initiatorID, _, _ := generateUserThenActivate()
+	subscriberID, _, _ := generateUserThenActivate()
+	token := authorizeUser()
+	room, _ := repository.NewChatRepository().CreateChat(initiatorID, subscriberID)
+
+	rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{
+		ChatID: room.ID,
+		Text:   "Halo",
+	}, token)
+	assert.NotEqual(t, 0, rv.Code)
+}
+
+func TestReadChatUpdatesUnreadCount(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+
+	var lastID int64
+	for _, text := range []string{"Halo", "Barang masih ada?"} {
+		rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, Text: text}, senderToken)
+		lastID = int64(rv.Result.(map[string]interface{})["id"].(float64))
+	}
+
+	chatOf := func(token string) types.Chat {
+		rv := reqGET(endpoint.ListChatRooms+"?offset=0&limit=10", token)
+		entries := rv.Result.(map[string]interface{})["entries"].([]interface{})
+		entry := types.Chat{}
+		mapToJSON(entries[0].(map[string]interface{}), &entry)
+		return entry
+	}
+
+	assert.Equal(t, 2, chatOf(receiverToken).Unread)
+	assert.Equal(t, 0, chatOf(senderToken).Unread)
+
+	rv := reqPOST(endpoint.ReadChat, service.ReadChatQuery{ChatID: room.ID}, receiverToken)
+	assert.Equal(t, 0, rv.Code)
+	assert.Equal(t, 0, chatOf(receiverToken).Unread)
+	assert.Equal(t, lastID, chatOf(senderToken).PartnerReadID)
+
+	// read pointer tidak boleh mundur
+	reqPOST(endpoint.ReadChat, service.ReadChatQuery{ChatID: room.ID, MessageID: lastID - 1}, receiverToken)
+	assert.Equal(t, lastID, chatOf(senderToken).PartnerReadID)
+}
+
+func TestReadChatNotParticipant(t *testing.T) {
+	initiatorID, _, _ := generateUserThenActivate()
+	subscriberID, _, _ := generateUserThenActivate()
+	token := authorizeUser()
+	room, _ := repository.NewChatRepository().CreateChat(initiatorID, subscriberID)
+
+	rv := reqPOST(endpoint.ReadChat, service.ReadChatQuery{ChatID: room.ID}, token)
+	assert.NotEqual(t, 0, rv.Code)
+
+	rv = reqGET(fmt.Sprintf("%s?chat_id=%d", endpoint.GetPresence, room.ID), token)
+	assert.NotEqual(t, 0, rv.Code)
+}
+
+func TestGetPresenceOffline(t *testing.T) {
+	userID, token := authorizeUserWithID()
+	partnerID, _, _ := generateUserThenActivate()
+	room, _ := repository.NewChatRepository().CreateChat(userID, partnerID)
+
+	rv := reqGET(fmt.Sprintf("%s?chat_id=%d", endpoint.GetPresence, room.ID), token)
+	assert.Equal(t, 0, rv.Code)
+
+	presence := chat.Presence{}
+	mapToJSON(rv.Result.(map[string]interface{}), &presence)
+	assert.Equal(t, partnerID, presence.UserID)
+	assert.Equal(t, false, presence.Online)
+}
+
+func TestCreateChatRoomDeduplicated(t *testing.T) {
+	userID, token := authorizeUserWithID()
+	partnerID, partnerToken := authorizeUserWithID()
+
+	rv := reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: partnerID}, token)
+	assert.Equal(t, 0, rv.Code)
+	first := rv.Result.(map[string]interface{})["id"]
+
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: partnerID}, token)
+	assert.Equal(t, first, rv.Result.(map[string]interface{})["id"])
+
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: userID}, partnerToken)
+	assert.Equal(t, first, rv.Result.(map[string]interface{})["id"])
+}
+
+func TestCreateChatRoomInvalidSubscriber(t *testing.T) {
+	userID, token := authorizeUserWithID()
+
+	rv := reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: userID}, token)
+	assert.NotEqual(t, 0, rv.Code)
+
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: -1}, token)
+	assert.NotEqual(t, 0, rv.Code)
+
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{}, token)
+	assert.NotEqual(t, 0, rv.Code)
+}
+
+func TestCreateProductChatRoom(t *testing.T) {
+	sellerID, sellerToken := authorizeUserWithID()
+	store := upgradeUser(sellerToken)
+	product, _ := createProduct(sellerToken, store.ID)
+	buyerToken := authorizeUser()
+
+	rv := reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{ProductID: product.ID}, buyerToken)
+	assert.Equal(t, 0, rv.Code)
+	room := rv.Result.(map[string]interface{})
+	assert.Equal(t, float64(sellerID), room["subscriber_id"])
+	assert.Equal(t, float64(product.ID), room["product_id"])
+	assert.Equal(t, product.ProductName, room["product"].(map[string]interface{})["product_name"])
+
+	// product harus milik user yang diajak chat
+	otherID, _, _ := generateUserThenActivate()
+	rv = reqPOST(endpoint.CreateChatRoom, service.CreateChatQuery{UserID: otherID, ProductID: product.ID}, buyerToken)
+	assert.NotEqual(t, 0, rv.Code)
+
+	query := fmt.Sprintf("%s?product_id=%d&limit=10", endpoint.ListProductChatRooms, product.ID)
+	rv = reqGET(query, sellerToken)
+	assert.Equal(t, 0, rv.Code)
+	entries := service.EntriesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &entries)
+	assert.Equal(t, 1, entries.Count)
+
+	rv = reqGET(query, buyerToken)
+	assert.Equal(t, 4010, rv.Code)
+}
+
+// sendTestMessage kirim message lalu kembalikan id-nya
+func sendTestMessage(t *testing.T, token string, chatID int64, text string) int64 {
+	rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: chatID, Text: text}, token)
+	assert.Equal(t, 0, rv.Code)
+	return int64(rv.Result.(map[string]interface{})["id"].(float64))
+}
+
+func TestListChatMessagesNotParticipant(t *testing.T) {
+	initiatorID, token := authorizeUserWithID()
+	subscriberID, _, _ := generateUserThenActivate()
+	room, _ := repository.NewChatRepository().CreateChat(initiatorID, subscriberID)
+	sendTestMessage(t, token, room.ID, "Rahasia")
+
+	rv := reqGET(fmt.Sprintf("%s?chat_id=%d&limit=10", endpoint.ListChatMessages, room.ID), authorizeUser())
+	assert.Equal(t, 4010, rv.Code)
+}
+
+func TestEditMessage(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	messageID := sendTestMessage(t, senderToken, room.ID, "Harga nett?")
+
+	rv := reqPOST(endpoint.EditMessage, service.EditMessageQuery{MessageID: messageID, Text: "Harga nego?"}, receiverToken)
+	assert.NotEqual(t, 0, rv.Code)
+
+	rv = reqPOST(endpoint.EditMessage, service.EditMessageQuery{MessageID: messageID, Text: "Harga nego?"}, senderToken)
+	assert.Equal(t, 0, rv.Code)
+	reply := rv.Result.(map[string]interface{})
+	assert.Equal(t, "Harga nego?", reply["text"])
+	assert.NotNil(t, reply["edited_at"])
+}
+
+func TestEditMessageWindowExpired(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, _, _ := generateUserThenActivate()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	messageID := sendTestMessage(t, senderToken, room.ID, "Halo")
+
+	sent := time.Now().UTC().Add(-chat.EditWindow - time.Minute)
+	app.DB.Model(&models.Message{}).Where("id = ?", messageID).Update("ts", sent)
+
+	rv := reqPOST(endpoint.EditMessage, service.EditMessageQuery{MessageID: messageID, Text: "Halo lagi"}, senderToken)
+	assert.Equal(t, chat.ErrWindowExpired.Error(), rv.Description)
+}
+
+func TestDeleteMessageForMe(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+	messageID := sendTestMessage(t, senderToken, room.ID, "Halo")
+
+	rv := reqPOST(endpoint.DeleteMessage, service.DeleteMessageQuery{MessageID: messageID}, receiverToken)
+	assert.Equal(t, 0, rv.Code)
+
+	_, count, _ := chatRepo.GetChatMessages(room.ID, receiverID, 0, 10)
+	assert.Equal(t, 0, count)
+	_, count, _ = chatRepo.GetChatMessages(room.ID, senderID, 0, 10)
+	assert.Equal(t, 1, count)
+
+	// message yang sudah dihapus dari history tidak bisa dihapus lagi
+	rv = reqPOST(endpoint.DeleteMessage, service.DeleteMessageQuery{MessageID: messageID}, receiverToken)
+	assert.NotEqual(t, 0, rv.Code)
+}
+
+func TestDeleteMessageForEveryone(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+	messageID := sendTestMessage(t, senderToken, room.ID, "Salah kirim")
+
+	rv := reqPOST(endpoint.DeleteMessage, service.DeleteMessageQuery{MessageID: messageID, ForEveryone: true}, receiverToken)
+	assert.Equal(t, chat.ErrNotSender.Error(), rv.Description)
+
+	rv = reqPOST(endpoint.DeleteMessage, service.DeleteMessageQuery{MessageID: messageID, ForEveryone: true}, senderToken)
+	assert.Equal(t, 0, rv.Code)
+
+	// isi message dihapus langsung pada row, history kedua participant tetap ada sebagai tombstone
+	message, err := chatRepo.GetMessageByID(messageID)
+	assert.Nil(t, err)
+	assert.Equal(t, true, message.Deleted)
+	assert.Equal(t, "", message.Text)
+
+	for _, userID := range []int64{senderID, receiverID} {
+		messages, count, err := chatRepo.GetChatMessages(room.ID, userID, 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, count)
+		if assert.Equal(t, 1, len(messages)) {
+			assert.Equal(t, messageID, messages[0].ID)
+			assert.Equal(t, true, messages[0].Deleted)
+			assert.Equal(t, "", messages[0].Text)
+		}
+	}
+
+	// message yang sudah dihapus tidak bisa dihapus lagi untuk semua participant
+	rv = reqPOST(endpoint.DeleteMessage, service.DeleteMessageQuery{MessageID: messageID, ForEveryone: true}, senderToken)
+	assert.NotEqual(t, 0, rv.Code)
+
+	states, _ := chatRepo.GetChatReadStates(receiverID, []int64{room.ID})
+	assert.Equal(t, 0, states[room.ID].Unread)
+}
+
+func TestSearchMessages(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	sendTestMessage(t, senderToken, room.ID, "Sepeda lipat masih ada?")
+	sendTestMessage(t, senderToken, room.ID, "Kalau sepeda gunung?")
+	sendTestMessage(t, receiverToken, room.ID, "Masih, silakan")
+
+	rv := reqGET(fmt.Sprintf("%s?query=sepeda&limit=1", endpoint.SearchMessages), receiverToken)
+	assert.Equal(t, 0, rv.Code)
+	result := service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 1, len(result.Entries))
+	assert.Equal(t, "Kalau <mark>sepeda</mark> gunung?", result.Entries[0].Snippet)
+	assert.NotEqual(t, int64(0), result.NextCursor)
+
+	// halaman berikutnya dari cursor
+	rv = reqGET(fmt.Sprintf("%s?query=sepeda&limit=1&before=%d", endpoint.SearchMessages, result.NextCursor), receiverToken)
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 1, len(result.Entries))
+	assert.Equal(t, "Sepeda lipat masih ada?", result.Entries[0].Text)
+
+	rv = reqGET(fmt.Sprintf("%s?query=masih&sender_id=%d&limit=10", endpoint.SearchMessages, receiverID), receiverToken)
+	result = service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 1, len(result.Entries))
+	assert.Equal(t, receiverID, result.Entries[0].SenderID)
+
+	// hanya history milik user sendiri yang dicari
+	rv = reqGET(fmt.Sprintf("%s?query=sepeda&limit=10", endpoint.SearchMessages), authorizeUser())
+	result = service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 0, len(result.Entries))
+}
+
+func TestSearchMessagesEscapeSnippet(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, _ := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	sendTestMessage(t, senderToken, room.ID, "Helm \"bogo\" & \x02jaket\x03 <script>")
+
+	rv := reqGET(fmt.Sprintf("%s?query=helm&limit=10", endpoint.SearchMessages), senderToken)
+	result := service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	if assert.Equal(t, 1, len(result.Entries)) {
+		// text message di-escape, hanya penanda dari server yang menjadi <mark>
+		snippet := result.Entries[0].Snippet
+		assert.True(t, strings.HasPrefix(snippet, "<mark>Helm</mark> &#34;bogo&#34; &amp; jaket"))
+		assert.Equal(t, 1, strings.Count(snippet, "<mark>"))
+		assert.False(t, strings.Contains(snippet, "<script>"))
+	}
+}
+
+func TestSearchMessagesFilter(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, _ := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+	sendTestMessage(t, senderToken, room.ID, "Harga nego?")
+
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	rv := reqGET(fmt.Sprintf("%s?query=nego&since=%s&limit=10", endpoint.SearchMessages, tomorrow), senderToken)
+	result := service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 0, len(result.Entries))
+
+	rv = reqGET(fmt.Sprintf("%s?query=nego&until=%s&chat_id=%d&limit=10", endpoint.SearchMessages, tomorrow, room.ID), senderToken)
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 1, len(result.Entries))
+
+	rv = reqGET(fmt.Sprintf("%s?query=nego&kind=image&limit=10", endpoint.SearchMessages), senderToken)
+	result = service.SearchMessagesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &result)
+	assert.Equal(t, 0, len(result.Entries))
+
+	rv = reqGET(fmt.Sprintf("%s?kind=video&limit=10", endpoint.SearchMessages), senderToken)
+	assert.Equal(t, 4000, rv.Code)
+
+	rv = reqGET(fmt.Sprintf("%s?chat_id=%d&query=nego&limit=10", endpoint.ListChatMessages, room.ID), senderToken)
+	entries := service.EntriesResult{}
+	mapToJSON(rv.Result.(map[string]interface{}), &entries)
+	assert.Equal(t, 1, entries.Count)
+}
@@ -0,0 +1,119 @@
+package test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/system/cluster"
+	"github.com/stretchr/testify/assert"
+)
+
+// startNode jalankan node cluster in-process, semua broadcast yang diterima dikirim ke channel
+func startNode(t *testing.T) (*cluster.Cluster, chan cluster.Message) {
+	received := make(chan cluster.Message, 10)
+	node := cluster.New(func(msg cluster.Message) {
+		received <- msg
+	})
+	if err := node.Start(); err != nil {
+		t.Fatal(err)
+	}
+	return node, received
+}
+
+func waitMessage(t *testing.T, received chan cluster.Message) cluster.Message {
+	select {
+	case msg := <-received:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcast not received")
+	}
+	return cluster.Message{}
+}
+
+func assertNoMessage(t *testing.T, received chan cluster.Message) {
+	select {
+	case msg := <-received:
+		t.Fatalf("unexpected broadcast %s", msg.Event)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestClusterBroadcastReachesOtherNode(t *testing.T) {
+	nodeA, receivedA := startNode(t)
+	defer nodeA.Stop()
+	nodeB, receivedB := startNode(t)
+	defer nodeB.Stop()
+
+	payload := map[string]interface{}{"text": "Halo"}
+	assert.Nil(t, nodeA.Broadcast("/chat", "chat:1", "reply", payload))
+
+	// dikirim langsung ke koneksi lokal, tidak dikirim ulang dari NOTIFY
+	local := waitMessage(t, receivedA)
+	assert.Equal(t, payload, local.Args)
+	assertNoMessage(t, receivedA)
+
+	remote := waitMessage(t, receivedB)
+	assert.Equal(t, "/chat", remote.Namespace)
+	assert.Equal(t, "chat:1", remote.Room)
+	assert.Equal(t, "reply", remote.Event)
+	assert.JSONEq(t, `{"text":"Halo"}`, string(remote.Args.(json.RawMessage)))
+}
+
+func TestClusterBroadcastLargePayload(t *testing.T) {
+	nodeA, receivedA := startNode(t)
+	defer nodeA.Stop()
+	nodeB, receivedB := startNode(t)
+	defer nodeB.Stop()
+
+	text := strings.Repeat("a", 10000)
+	assert.Nil(t, nodeA.Broadcast("/chat", "chat:1", "reply", text))
+	waitMessage(t, receivedA)
+
+	remote := waitMessage(t, receivedB)
+	decoded := ""
+	json.Unmarshal(remote.Args.(json.RawMessage), &decoded)
+	assert.Equal(t, text, decoded)
+}
+
+func TestClusterPresence(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	nodeA, _ := startNode(t)
+	defer nodeA.Stop()
+	nodeB, _ := startNode(t)
+	defer nodeB.Stop()
+
+	assert.Equal(t, false, nodeB.Online(userID))
+
+	first, err := nodeA.Connected(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, true, first)
+	assert.Equal(t, true, nodeB.Online(userID))
+
+	first, _ = nodeB.Connected(userID)
+	assert.Equal(t, false, first)
+
+	last, err := nodeA.Disconnected(userID)
+	assert.Nil(t, err)
+	assert.Equal(t, false, last)
+	assert.Equal(t, true, nodeA.Online(userID))
+
+	last, _ = nodeB.Disconnected(userID)
+	assert.Equal(t, true, last)
+	assert.Equal(t, false, nodeA.Online(userID))
+}
+
+func TestClusterStopRemovesPresence(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	nodeA, _ := startNode(t)
+	nodeB, _ := startNode(t)
+	defer nodeB.Stop()
+
+	nodeA.Connected(userID)
+	online, _ := nodeB.OnlineUsers([]int64{userID})
+	assert.Equal(t, []int64{userID}, online)
+
+	nodeA.Stop()
+	assert.Equal(t, false, nodeB.Online(userID))
+}
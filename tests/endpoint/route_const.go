@@ -1,6 +1,12 @@
 package endpoint
 
 const (
+	// UploadAttachment endpoint for testing only
+	UploadAttachment = "/attachment/v1/upload"
+	// AttachmentURL endpoint for testing only
+	AttachmentURL = "/attachment/v1/url"
+	// DownloadAttachment endpoint for testing only
+	DownloadAttachment = "/attachment/v1/download"
 	// AuthorizeUser endpoint for testing only
 	AuthorizeUser = "/auth/v1/authorize"
 	// UnauthorizeUser endpoint for testing only
@@ -9,10 +15,30 @@ const (
 	CreateChatRoom = "/chat/v1/new-room"
 	// ListChatRooms endpoint for testing only
 	ListChatRooms = "/chat/v1/list"
+	// ListProductChatRooms endpoint for testing only
+	ListProductChatRooms = "/chat/v1/product-rooms"
 	// SendMessage endpoint for testing only
 	SendMessage = "/chat/v1/send-message"
 	// ListChatMessages endpoint for testing only
 	ListChatMessages = "/chat/v1/list-messages"
+	// EditMessage endpoint for testing only
+	EditMessage = "/chat/v1/edit-message"
+	// DeleteMessage endpoint for testing only
+	DeleteMessage = "/chat/v1/delete-message"
+	// ReadChat endpoint for testing only
+	ReadChat = "/chat/v1/read"
+	// GetPresence endpoint for testing only
+	GetPresence = "/chat/v1/presence"
+	// SearchMessages endpoint for testing only
+	SearchMessages = "/chat/v1/search-messages"
+	// ListDuplicateFlags endpoint for testing only
+	ListDuplicateFlags = "/moderation/v1/duplicate-flags"
+	// ResolveDuplicateFlag endpoint for testing only
+	ResolveDuplicateFlag = "/moderation/v1/resolve-duplicate"
+	// UploadProductImage endpoint for testing only
+	UploadProductImage = "/product-image/v1/upload"
+	// ProductImageFile endpoint for testing only
+	ProductImageFile = "/product-image/v1/file"
 	// AddProduct endpoint for testing only
 	AddProduct = "/product/v1/add"
 	// ListProduct endpoint for testing only
@@ -33,6 +59,8 @@ const (
 	ReOpenProductBid = "/product/v1/reopen"
 	// MarkProductAsSold endpoint for testing only
 	MarkProductAsSold = "/product/v1/mark-as-sold"
+	// StreamEvents endpoint for testing only
+	StreamEvents = "/stream/v1/events"
 	// RegisterUser endpoint for testing only
 	RegisterUser = "/user/v1/register"
 	// ActivateUser endpoint for testing only
@@ -41,6 +69,8 @@ const (
 	MeInfo = "/user/v1/me/info"
 	// UpdateUserInfo endpoint for testing only
 	UpdateUserInfo = "/user/v1/me/info"
+	// SetUserLocale endpoint for testing only
+	SetUserLocale = "/user/v1/me/locale"
 	// GetUserStore endpoint for testing only
 	GetUserStore = "/user/v1/me/store"
 	// BecomeAuctioneer endpoint for testing only
@@ -53,6 +83,40 @@ const (
 	ConnectRemove = "/user/v1/connect-remove"
 	// ListUserNotifs endpoint for testing only
 	ListUserNotifs = "/user/v1/notifs"
+	// UnreadNotifCount endpoint for testing only
+	UnreadNotifCount = "/user/v1/notifs/unread-count"
 	// MarkAsReadNotif endpoint for testing only
 	MarkAsReadNotif = "/user/v1/notifs/read"
+	// MarkAllAsReadNotif endpoint for testing only
+	MarkAllAsReadNotif = "/user/v1/notifs/read-all"
+	// DeleteNotifs endpoint for testing only
+	DeleteNotifs = "/user/v1/notifs/delete"
+	// GetNotifSettings endpoint for testing only
+	GetNotifSettings = "/user/v1/notifs/settings"
+	// SetNotifPreference endpoint for testing only
+	SetNotifPreference = "/user/v1/notifs/preference"
+	// SetQuietHours endpoint for testing only
+	SetQuietHours = "/user/v1/notifs/quiet-hours"
+	// SetDailyDigest endpoint for testing only
+	SetDailyDigest = "/user/v1/notifs/digest"
+	// BlockUser endpoint for testing only
+	BlockUser = "/user/v1/block"
+	// UnblockUser endpoint for testing only
+	UnblockUser = "/user/v1/unblock"
+	// ListBlockedUsers endpoint for testing only
+	ListBlockedUsers = "/user/v1/blocks"
+	// AddWebhook endpoint for testing only
+	AddWebhook = "/webhook/v1/add"
+	// ListWebhooks endpoint for testing only
+	ListWebhooks = "/webhook/v1/list"
+	// DeleteWebhook endpoint for testing only
+	DeleteWebhook = "/webhook/v1/delete"
+	// EnableWebhook endpoint for testing only
+	EnableWebhook = "/webhook/v1/enable"
+	// ListDeliveries endpoint for testing only
+	ListDeliveries = "/webhook/v1/deliveries"
+	// ListDeliveryAttempts endpoint for testing only
+	ListDeliveryAttempts = "/webhook/v1/deliveries/attempts"
+	// Redeliver endpoint for testing only
+	Redeliver = "/webhook/v1/redeliver"
 )
\ No newline at end of file
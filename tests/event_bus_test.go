@@ -0,0 +1,93 @@
+package test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/event"
+	"github.com/go-playground/assert/v2"
+	"github.com/jinzhu/gorm"
+	"syreclabs.com/go/faker"
+)
+
+type testBusEvent struct {
+	Aggregate string `json:"aggregate"`
+	Seq       int    `json:"seq"`
+}
+
+func (e *testBusEvent) AggregateID() string {
+	return e.Aggregate
+}
+
+var (
+	busMu       sync.Mutex
+	busReceived = map[string][]string{}
+)
+
+func recordBusEvent(subscriber string) event.Handler {
+	return func(ev interface{}) error {
+		e := ev.(*testBusEvent)
+		busMu.Lock()
+		defer busMu.Unlock()
+		key := subscriber + ":" + e.Aggregate
+		busReceived[key] = append(busReceived[key], fmt.Sprint(e.Seq))
+		return nil
+	}
+}
+
+func busEvents(key string) []string {
+	busMu.Lock()
+	defer busMu.Unlock()
+	return append([]string{}, busReceived[key]...)
+}
+
+func init() {
+	event.DefaultBus.Subscribe(&testBusEvent{}, "test.first", recordBusEvent("first"))
+	event.DefaultBus.Subscribe(&testBusEvent{}, "test.second", recordBusEvent("second"))
+}
+
+func TestEventBusDeliversInOrderToEverySubscriber(t *testing.T) {
+	aggregate := faker.RandomString(16)
+	for seq := 1; seq <= 3; seq++ {
+		err := event.DefaultBus.Publish(&testBusEvent{Aggregate: aggregate, Seq: seq})
+		assert.Equal(t, err, nil)
+	}
+
+	runDispatcher(t, func() bool {
+		return len(busEvents("first:"+aggregate)) == 3 && len(busEvents("second:"+aggregate)) == 3
+	})
+
+	assert.Equal(t, busEvents("first:"+aggregate), []string{"1", "2", "3"})
+	assert.Equal(t, busEvents("second:"+aggregate), []string{"1", "2", "3"})
+}
+
+func TestOutboxRelayPublishesRecordedEvent(t *testing.T) {
+	aggregate := faker.RandomString(16)
+	err := app.DB.Transaction(func(tx *gorm.DB) error {
+		return event.Record(tx, &testBusEvent{Aggregate: aggregate, Seq: 1})
+	})
+	assert.Equal(t, err, nil)
+
+	relay := event.NewOutboxRelay(event.DefaultBus)
+	go relay.Start()
+	defer relay.Stop()
+
+	runDispatcher(t, func() bool {
+		return len(busEvents("first:"+aggregate)) == 1 && len(busEvents("second:"+aggregate)) == 1
+	})
+}
+
+func TestOutboxRolledBackEventIsNotPublished(t *testing.T) {
+	aggregate := faker.RandomString(16)
+	app.DB.Transaction(func(tx *gorm.DB) error {
+		event.Record(tx, &testBusEvent{Aggregate: aggregate, Seq: 1})
+		return errors.New("rollback")
+	})
+
+	count, _ := models.NewOutboxEventQuerySet(app.DB).AggregateIDEq(aggregate).Count()
+	assert.Equal(t, count, 0)
+}
@@ -5,11 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
 	"io/ioutil"
 	"log"
+	"math/rand"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/fatkhur1960/goauction/app"
@@ -19,6 +26,8 @@ import (
 	"github.com/fatkhur1960/goauction/app/service"
 	"github.com/fatkhur1960/goauction/app/types"
 	"github.com/fatkhur1960/goauction/app/utils"
+	"github.com/fatkhur1960/goauction/system/storage"
+	"github.com/fatkhur1960/goauction/system/webhook"
 	"github.com/fatkhur1960/goauction/tests/endpoint"
 	"github.com/gin-gonic/gin"
 	"github.com/mitchellh/mapstructure"
@@ -32,6 +41,9 @@ var (
 
 func getTestingRoutes() *gin.Engine {
 	app.ConnectDatabaseTest()
+	storage.SetDefault(storage.NewLocalStorage(filepath.Join(os.TempDir(), "goauction-test-storage")))
+	// receiver webhook pada test berjalan di localhost
+	webhook.AllowPrivateTargets = true
 	gin.SetMode(gin.TestMode)
 	router := router.GetGeneratedRoutes(gin.New())
 	return router
@@ -131,7 +143,12 @@ func generateUserThenActivate() (int64, string, string) {
 }
 
 func authorizeUser() string {
-	_, email, passhash := generateUserThenActivate()
+	_, token := authorizeUserWithID()
+	return token
+}
+
+func authorizeUserWithID() (int64, string) {
+	userID, email, passhash := generateUserThenActivate()
 	payload := service.AuthQuery{
 		Email:    email,
 		Passhash: passhash,
@@ -139,7 +156,7 @@ func authorizeUser() string {
 
 	rv := reqPOST(endpoint.AuthorizeUser, payload)
 	rMap := rv.Result.(map[string]interface{})
-	return rMap["token"].(string)
+	return userID, rMap["token"].(string)
 }
 
 func upgradeUser(token string) *models.Store {
@@ -161,7 +178,7 @@ func upgradeUser(token string) *models.Store {
 
 func closeProduct(productID int64) {
 	repo := repository.NewProductRepository()
-	repo.CloseProduct(productID)
+	repo.CloseProduct(productID, nil)
 }
 
 func createProduct(token string, storeID int64) (types.Product, error) {
@@ -171,16 +188,16 @@ func createProduct(token string, storeID int64) (types.Product, error) {
 		Value: "value",
 	})
 	payload := repository.NewProductQuery{
-		StoreID:       storeID,
-		ProductName:   faker.Commerce().ProductName(),
-		ProductImages: []string{faker.Internet().Url()},
-		Desc:          faker.RandomString(100),
-		Condition:     1,
-		ConditionAvg:  100,
-		StartPrice:    50000,
-		BidMultpl:     50000,
-		ClosedAT:      utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
-		Labels:        labels,
+		StoreID:      storeID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{uploadTestImage(token)},
+		Desc:         faker.RandomString(100),
+		Condition:    1,
+		ConditionAvg: 100,
+		StartPrice:   50000,
+		BidMultpl:    50000,
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       labels,
 	}
 
 	rv := reqPOST(endpoint.AddProduct, payload, token)
@@ -205,3 +222,44 @@ func mapToJSON(input map[string]interface{}, output interface{}) interface{} {
 
 	return output
 }
+
+// testImage png acak berukuran width x height yang terdiri dari blok warna,
+// setiap gambar berbeda agar tidak dianggap duplikat
+func testImage(width int, height int) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	const block = 40
+	for by := 0; by < height; by += block {
+		for bx := 0; bx < width; bx += block {
+			c := color.RGBA{uint8(rand.Intn(256)), uint8(rand.Intn(256)), uint8(rand.Intn(256)), 255}
+			draw.Draw(img, image.Rect(bx, by, bx+block, by+block), image.NewUniform(c), image.Point{}, draw.Src)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	png.Encode(buf, img)
+	return buf.Bytes()
+}
+
+func uploadProductImage(token string, fileName string, data []byte) app.Result {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(data)
+	writer.Close()
+
+	req, _ := http.NewRequest("POST", fmt.Sprintf("%s/api%s", ts.URL, endpoint.UploadProductImage), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := client.Do(req)
+
+	return parseResult(resp, err)
+}
+
+// uploadTestImage upload gambar acak lalu kembalikan id-nya, 0 jika gagal
+func uploadTestImage(token string) int64 {
+	rv := uploadProductImage(token, "produk.png", testImage(400, 400))
+	if rv.Code != 0 {
+		return 0
+	}
+	return int64(rv.Result.(map[string]interface{})["id"].(float64))
+}
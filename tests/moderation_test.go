@@ -0,0 +1,152 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/service"
+	"github.com/fatkhur1960/goauction/app/types"
+	"github.com/fatkhur1960/goauction/app/utils"
+	"github.com/fatkhur1960/goauction/system/imaging"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/nfnt/resize"
+	"github.com/stretchr/testify/assert"
+	"syreclabs.com/go/faker"
+)
+
+func authorizeAdmin() string {
+	userID, token := authorizeUserWithID()
+	models.NewUserQuerySet(app.DB).IDEq(userID).GetUpdater().SetType(models.UserTypeAdmin).Update()
+
+	return token
+}
+
+func addProductWithImage(token string, storeID int64, data []byte) types.Product {
+	rv := uploadProductImage(token, "produk.jpg", data)
+	payload := repository.NewProductQuery{
+		StoreID:      storeID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{toProductImage(rv.Result).ID},
+		Desc:         faker.RandomString(100),
+		Condition:    1,
+		ConditionAvg: 100,
+		StartPrice:   50000,
+		BidMultpl:    50000,
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       []repository.LabelQuery{{Name: "label_name", Value: "value"}},
+	}
+	rv = reqPOST(endpoint.AddProduct, payload, token)
+	product := types.Product{}
+	mapToJSON(rv.Result.(map[string]interface{}), &product)
+
+	return product
+}
+
+// resizedCopy gambar yang sama dengan ukuran dan kualitas jpeg berbeda, seperti foto yang disimpan ulang
+func resizedCopy(data []byte) []byte {
+	img, _, _ := image.Decode(bytes.NewReader(data))
+	buf := &bytes.Buffer{}
+	jpeg.Encode(buf, resize.Resize(360, 360, img, resize.Bilinear), &jpeg.Options{Quality: 60})
+	return buf.Bytes()
+}
+
+func pendingFlag(token string, productID int64) *service.DuplicateFlag {
+	rv := reqGET(endpoint.ListDuplicateFlags+"?offset=0&limit=100", token)
+	entries := struct {
+		Entries []service.DuplicateFlag `json:"entries"`
+	}{}
+	mapToJSON(rv.Result.(map[string]interface{}), &entries)
+	for _, flag := range entries.Entries {
+		if flag.Product.ProductID == productID {
+			return &flag
+		}
+	}
+	return nil
+}
+
+func TestDuplicateImageHash(t *testing.T) {
+	original := testImage(400, 400)
+	a, _ := imaging.Process(original)
+	b, _ := imaging.Process(resizedCopy(original))
+	c, _ := imaging.Process(testImage(400, 400))
+
+	assert.True(t, imaging.HashDistance(a.Hash, b.Hash) <= imaging.DuplicateDistance)
+	assert.True(t, imaging.HashDistance(a.Hash, c.Hash) > imaging.DuplicateDistance)
+}
+
+func TestDuplicateImageFlaggedAndDismissed(t *testing.T) {
+	sellerToken := authorizeUser()
+	sellerStore := upgradeUser(sellerToken)
+	scammerToken := authorizeUser()
+	scammerStore := upgradeUser(scammerToken)
+	adminToken := authorizeAdmin()
+
+	photo := testImage(400, 400)
+	original := addProductWithImage(sellerToken, sellerStore.ID, photo)
+	assert.False(t, original.DuplicateWarning)
+
+	copied := addProductWithImage(scammerToken, scammerStore.ID, resizedCopy(photo))
+	assert.True(t, copied.DuplicateWarning)
+
+	flag := pendingFlag(adminToken, copied.ID)
+	assert.NotNil(t, flag)
+	assert.Equal(t, original.ID, flag.Matched.ProductID)
+	assert.Equal(t, scammerStore.ID, flag.Product.StoreID)
+
+	// hanya admin yang bisa memoderasi
+	rv := reqGET(endpoint.ListDuplicateFlags+"?offset=0&limit=10", scammerToken)
+	assert.Equal(t, 4030, rv.Code)
+	rv = reqPOST(endpoint.ResolveDuplicateFlag, service.ResolveDuplicateQuery{ID: flag.ID, Action: service.ResolveDismiss}, scammerToken)
+	assert.Equal(t, 4030, rv.Code)
+
+	rv = reqPOST(endpoint.ResolveDuplicateFlag, service.ResolveDuplicateQuery{ID: flag.ID, Action: service.ResolveDismiss}, adminToken)
+	assert.Equal(t, 0, rv.Code)
+	assert.Nil(t, pendingFlag(adminToken, copied.ID))
+
+	rv = reqGET(endpoint.DetailProduct+fmt.Sprintf("?id=%d", copied.ID), scammerToken)
+	detail := types.ProductDetail{}
+	mapToJSON(rv.Result.(map[string]interface{}), &detail)
+	assert.False(t, detail.DuplicateWarning)
+
+	// pasangan gambar yang sudah diputuskan tidak diflag ulang
+	count, _ := repository.NewProductRepository().FlagDuplicateImages(copied.ID, imaging.DuplicateDistance)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestDuplicateImageRemoved(t *testing.T) {
+	sellerToken := authorizeUser()
+	sellerStore := upgradeUser(sellerToken)
+	scammerToken := authorizeUser()
+	scammerStore := upgradeUser(scammerToken)
+	adminToken := authorizeAdmin()
+
+	photo := testImage(400, 400)
+	addProductWithImage(sellerToken, sellerStore.ID, photo)
+	copied := addProductWithImage(scammerToken, scammerStore.ID, photo)
+	flag := pendingFlag(adminToken, copied.ID)
+	assert.NotNil(t, flag)
+
+	rv := reqPOST(endpoint.ResolveDuplicateFlag, service.ResolveDuplicateQuery{ID: flag.ID, Action: service.ResolveRemove}, adminToken)
+	assert.Equal(t, 0, rv.Code)
+
+	_, err := repository.NewProductRepository().GetByID(copied.ID)
+	assert.NotNil(t, err)
+}
+
+func TestDuplicateImageSameStore(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+
+	// product lain dari store yang sama boleh memakai foto yang sama
+	photo := testImage(400, 400)
+	addProductWithImage(token, store.ID, photo)
+	product := addProductWithImage(token, store.ID, photo)
+	assert.False(t, product.DuplicateWarning)
+}
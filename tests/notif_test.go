@@ -0,0 +1,277 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/service"
+	"github.com/fatkhur1960/goauction/system/core"
+	"github.com/fatkhur1960/goauction/system/notificator"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/go-playground/assert/v2"
+)
+
+// fakeChannel mencatat penerima notif, noRecipient mensimulasikan user tanpa device
+type fakeChannel struct {
+	mu          sync.Mutex
+	name        string
+	noRecipient bool
+	received    []int64
+}
+
+func (ch *fakeChannel) Name() string {
+	return ch.name
+}
+
+func (ch *fakeChannel) Send(payload *notificator.Payload) error {
+	if ch.noRecipient {
+		return notificator.ErrNoRecipient
+	}
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	ch.received = append(ch.received, payload.ReceiverID)
+	return nil
+}
+
+func (ch *fakeChannel) receivers() []int64 {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+	return append([]int64{}, ch.received...)
+}
+
+func TestInQuietHours(t *testing.T) {
+	utc := func(hour, min int) time.Time {
+		return time.Date(2020, 7, 1, hour, min, 0, 0, time.UTC)
+	}
+
+	assert.Equal(t, notificator.InQuietHours("22:00", "07:00", "UTC", utc(23, 0)), true)
+	assert.Equal(t, notificator.InQuietHours("22:00", "07:00", "UTC", utc(6, 59)), true)
+	assert.Equal(t, notificator.InQuietHours("22:00", "07:00", "UTC", utc(7, 0)), false)
+	assert.Equal(t, notificator.InQuietHours("12:00", "13:00", "UTC", utc(12, 30)), true)
+	assert.Equal(t, notificator.InQuietHours("12:00", "13:00", "UTC", utc(13, 30)), false)
+	assert.Equal(t, notificator.InQuietHours("", "", "UTC", utc(13, 30)), false)
+}
+
+func TestNotifFallbackToEmail(t *testing.T) {
+	push := &fakeChannel{name: notificator.ChannelPush, noRecipient: true}
+	email := &fakeChannel{name: notificator.ChannelEmail}
+	notificator.RegisterChannel(push)
+	notificator.RegisterChannel(email)
+
+	userID, _, _ := generateUserThenActivate()
+	err := notificator.NewNotifHandler().Send(&notificator.Payload{
+		ReceiverID: userID,
+		NotifKind:  core.GotBidder,
+		Title:      "title",
+		Message:    "message",
+	})
+	assert.Equal(t, err, nil)
+
+	// push tidak punya penerima, job fallback ke email diantrikan
+	runDispatcher(t, func() bool {
+		return len(email.receivers()) > 0
+	})
+	assert.Equal(t, email.receivers(), []int64{userID})
+}
+
+func TestSetNotifPreference(t *testing.T) {
+	token := authorizeUser()
+	notifType := int(core.GotMessage)
+	payload := service.NotifPreferenceQuery{
+		NotifType: &notifType,
+		Channels:  []string{notificator.ChannelEmail},
+	}
+
+	rv := reqPOST(endpoint.SetNotifPreference, payload, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqGET(endpoint.GetNotifSettings, token)
+	settings := service.NotifSettings{}
+	mapToJSON(rv.Result.(map[string]interface{}), &settings)
+	for _, preference := range settings.Preferences {
+		if preference.NotifType == notifType {
+			assert.Equal(t, preference.Channels, []string{notificator.ChannelEmail})
+		} else {
+			assert.Equal(t, preference.Channels, notificator.DefaultChannels(core.NotifType(preference.NotifType)))
+		}
+	}
+}
+
+func TestSetNotifPreferenceInvalidChannel(t *testing.T) {
+	token := authorizeUser()
+	notifType := int(core.GotMessage)
+	payload := service.NotifPreferenceQuery{
+		NotifType: &notifType,
+		Channels:  []string{"pigeon"},
+	}
+
+	rv := reqPOST(endpoint.SetNotifPreference, payload, token)
+	assert.NotEqual(t, rv.Code, 0)
+}
+
+func TestSetQuietHours(t *testing.T) {
+	token := authorizeUser()
+
+	rv := reqPOST(endpoint.SetQuietHours, service.QuietHoursQuery{Start: "22:00", End: "7am"}, token)
+	assert.NotEqual(t, rv.Code, 0)
+
+	rv = reqPOST(endpoint.SetQuietHours, service.QuietHoursQuery{Start: "22:00", End: "07:00"}, token)
+	assert.Equal(t, rv.Code, 0)
+}
+
+func TestFormatPrice(t *testing.T) {
+	assert.Equal(t, notificator.FormatPrice(notificator.LocaleID, 1250000), "Rp 1.250.000")
+	assert.Equal(t, notificator.FormatPrice(notificator.LocaleEN, 1250000), "Rp 1,250,000")
+	assert.Equal(t, notificator.FormatPrice(notificator.LocaleID, 500), "Rp 500")
+}
+
+func TestRenderNotifTemplate(t *testing.T) {
+	data := notificator.TemplateData{"user": "Budi", "product": "Sepeda", "price": 1250000.0}
+
+	title, message, err := notificator.Render(core.GotBidder, notificator.LocaleID, data)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, title, "Hai, Budi ngebid produk Anda")
+	assert.Equal(t, message, "Budi ngebid produk `Sepeda` dengan harga Rp 1.250.000")
+
+	_, message, _ = notificator.Render(core.GotBidder, notificator.LocaleEN, data)
+	assert.Equal(t, message, "Budi bid on `Sepeda` for Rp 1,250,000")
+
+	// locale tidak dikenal dirender dengan default locale
+	title, _, _ = notificator.Render(core.WinBid, "fr", data)
+	assert.Equal(t, title, "Selamat Anda menangkan bid untuk Sepeda")
+}
+
+func TestSetUserLocale(t *testing.T) {
+	token := authorizeUser()
+
+	rv := reqPOST(endpoint.SetUserLocale, service.LocaleQuery{Locale: "fr"}, token)
+	assert.NotEqual(t, rv.Code, 0)
+
+	rv = reqPOST(endpoint.SetUserLocale, service.LocaleQuery{Locale: notificator.LocaleEN}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqGET(endpoint.MeInfo, token)
+	assert.Equal(t, rv.Result.(map[string]interface{})["locale"], notificator.LocaleEN)
+}
+
+func TestNotifInbox(t *testing.T) {
+	userID, token := authorizeUserWithID()
+	notifRepo := repository.NewNotifRepository()
+	first, _ := notifRepo.CreateNotif(userID, "title", "content", core.GotBidder, 1)
+	notifRepo.CreateNotif(userID, "title", "content", core.GotBidder, 1)
+	notifRepo.CreateNotif(userID, "title", "content", core.WinBid, 1)
+
+	rv := reqGET(endpoint.UnreadNotifCount, token)
+	assert.Equal(t, rv.Result.(map[string]interface{})["count"], float64(3))
+
+	// halaman pertama berisi notif terbaru
+	page := service.NotifEntriesResult{}
+	rv = reqGET(endpoint.ListUserNotifs+"?limit=2", token)
+	mapToJSON(rv.Result.(map[string]interface{}), &page)
+	assert.Equal(t, len(page.Entries), 2)
+	assert.Equal(t, page.Entries[0].NotifType, int(core.WinBid))
+	assert.NotEqual(t, page.NextCursor, int64(0))
+
+	rv = reqGET(fmt.Sprintf("%s?limit=2&before=%d", endpoint.ListUserNotifs, page.NextCursor), token)
+	mapToJSON(rv.Result.(map[string]interface{}), &page)
+	assert.Equal(t, len(page.Entries), 1)
+	assert.Equal(t, page.Entries[0].ID, first.ID)
+	assert.Equal(t, page.NextCursor, int64(0))
+
+	rv = reqGET(fmt.Sprintf("%s?limit=10&notif_type=%d", endpoint.ListUserNotifs, core.GotBidder), token)
+	mapToJSON(rv.Result.(map[string]interface{}), &page)
+	assert.Equal(t, len(page.Entries), 2)
+
+	rv = reqPOST(endpoint.MarkAsReadNotif, service.ReadNotifQuery{NotifIds: []int64{first.ID}}, token)
+	assert.Equal(t, rv.Code, 0)
+	rv = reqGET(endpoint.ListUserNotifs+"?limit=10&read=false", token)
+	mapToJSON(rv.Result.(map[string]interface{}), &page)
+	assert.Equal(t, len(page.Entries), 2)
+
+	rv = reqPOST(endpoint.MarkAllAsReadNotif, nil, token)
+	assert.Equal(t, rv.Code, 0)
+	rv = reqGET(endpoint.UnreadNotifCount, token)
+	assert.Equal(t, rv.Result.(map[string]interface{})["count"], float64(0))
+
+	rv = reqPOST(endpoint.DeleteNotifs, service.ReadNotifQuery{NotifIds: []int64{first.ID}}, token)
+	assert.Equal(t, rv.Code, 0)
+	rv = reqGET(endpoint.ListUserNotifs+"?limit=10", token)
+	mapToJSON(rv.Result.(map[string]interface{}), &page)
+	assert.Equal(t, len(page.Entries), 2)
+}
+
+func TestPruneReadNotifs(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	notifRepo := repository.NewNotifRepository()
+	read, _ := notifRepo.CreateNotif(userID, "title", "content", core.GotBidder, 1)
+	unread, _ := notifRepo.CreateNotif(userID, "title", "content", core.GotBidder, 1)
+	notifRepo.MarkAsRead([]int64{read.ID}, userID)
+
+	_, err := notifRepo.PruneReadNotifs(time.Now().UTC().Add(time.Hour))
+	assert.Equal(t, err, nil)
+
+	notifs, _ := notifRepo.GetUserNotifs(userID, repository.NotifFilter{}, 0, 10)
+	assert.Equal(t, len(notifs), 1)
+	assert.Equal(t, notifs[0].ID, unread.ID)
+}
+
+func TestNotifyCoalesced(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	handler := notificator.NewNotifHandler()
+	for _, price := range []float64{1000, 1500, 2000} {
+		err := handler.NotifyCoalesced(userID, core.GotBidder, 77, nil, notificator.TemplateData{
+			"user": "Budi", "product": "Sepeda", "price": price,
+		})
+		assert.Equal(t, err, nil)
+	}
+
+	job := notificator.CoalescedNotifJob{ReceiverID: userID, NotifType: core.GotBidder, TargetID: 77}
+	assert.Equal(t, job.Handle(), nil)
+	// buffer sudah diambil, job kedua tidak mengirim apapun
+	assert.Equal(t, job.Handle(), nil)
+
+	notifs, _ := repository.NewNotifRepository().GetUserNotifs(userID, repository.NotifFilter{}, 0, 10)
+	assert.Equal(t, len(notifs), 1)
+	assert.Equal(t, notifs[0].Title, "3 bid baru untuk Sepeda")
+	assert.Equal(t, notifs[0].Content, "Bid tertinggi saat ini Rp 2.000 dari Budi")
+}
+
+func TestRenderOutbid(t *testing.T) {
+	data := notificator.TemplateData{"user": "Budi", "product": "Sepeda", "price": 2000.0}
+
+	title, message, _ := notificator.Render(core.Outbid, notificator.LocaleID, data)
+	assert.Equal(t, title, "Bid Anda untuk Sepeda dilampaui")
+	assert.Equal(t, message, "Budi menawar Rp 2.000, lebih tinggi dari bid Anda")
+
+	data["count"] = 4
+	_, message, _ = notificator.RenderCoalesced(core.Outbid, notificator.LocaleEN, data)
+	assert.Equal(t, message, "There are 4 new bids, the highest is Rp 2,000")
+}
+
+func TestSetDailyDigest(t *testing.T) {
+	token := authorizeUser()
+	enabled := true
+
+	rv := reqPOST(endpoint.SetDailyDigest, service.DailyDigestQuery{Enabled: &enabled}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqGET(endpoint.GetNotifSettings, token)
+	settings := service.NotifSettings{}
+	mapToJSON(rv.Result.(map[string]interface{}), &settings)
+	assert.Equal(t, settings.DailyDigest, true)
+}
+
+func TestCreateNotifTimestamp(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	notifRepo := repository.NewNotifRepository()
+
+	before := time.Now().UTC().Add(-time.Second)
+	notif, err := notifRepo.CreateNotif(userID, "title", "content", core.GotBidder, 1)
+	assert.Equal(t, err, nil)
+	// created_at waktu notif dibuat, bukan waktu proses dijalankan
+	assert.Equal(t, notif.CreatedAT.After(before), true)
+	assert.Equal(t, notif.CreatedAT.Before(time.Now().UTC().Add(time.Second)), true)
+}
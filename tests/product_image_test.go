@@ -0,0 +1,228 @@
+package test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/jpeg"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/utils"
+	"github.com/fatkhur1960/goauction/system/imaging"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/stretchr/testify/assert"
+	"syreclabs.com/go/faker"
+)
+
+// jpegWithOrientation jpeg width x height dengan exif orientation
+func jpegWithOrientation(width int, height int, orientation uint16) []byte {
+	img, _, _ := image.Decode(bytes.NewReader(testImage(width, height)))
+	buf := &bytes.Buffer{}
+	jpeg.Encode(buf, img, nil)
+	data := buf.Bytes()
+
+	// tiff big endian dengan satu entry ifd: Orientation (0x0112) SHORT
+	tiff := &bytes.Buffer{}
+	tiff.WriteString("MM\x00\x2a")
+	binary.Write(tiff, binary.BigEndian, uint32(8))
+	binary.Write(tiff, binary.BigEndian, uint16(1))
+	binary.Write(tiff, binary.BigEndian, []uint16{0x0112, 3})
+	binary.Write(tiff, binary.BigEndian, uint32(1))
+	binary.Write(tiff, binary.BigEndian, []uint16{orientation, 0})
+	binary.Write(tiff, binary.BigEndian, uint32(0))
+	payload := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	app1 := []byte{0xff, 0xe1}
+	app1 = append(app1, byte((len(payload)+2)>>8), byte(len(payload)+2))
+	app1 = append(app1, payload...)
+
+	// sisipkan APP1 setelah SOI
+	return append(append(append([]byte{}, data[:2]...), app1...), data[2:]...)
+}
+
+func toProductImage(result interface{}) models.ProductImage {
+	image := models.ProductImage{}
+	mapToJSON(result.(map[string]interface{}), &image)
+	return image
+}
+
+func fetchImage(t *testing.T, url string) ([]byte, *http.Response) {
+	resp, err := http.Get(ts.URL + url)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	data, _ := ioutil.ReadAll(resp.Body)
+	return data, resp
+}
+
+func TestUploadProductImage(t *testing.T) {
+	token := authorizeUser()
+
+	rv := uploadProductImage(token, "produk.png", testImage(1200, 900))
+	assert.Equal(t, 0, rv.Code)
+	uploaded := toProductImage(rv.Result)
+	assert.Equal(t, 1200, uploaded.Width)
+	assert.Equal(t, 900, uploaded.Height)
+
+	data, resp := fetchImage(t, uploaded.ImageURL)
+	assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+	config, _, _ := image.DecodeConfig(bytes.NewReader(data))
+	assert.Equal(t, 1200, config.Width)
+
+	data, resp = fetchImage(t, uploaded.MediumURL)
+	assert.Equal(t, "image/jpeg", resp.Header.Get("Content-Type"))
+	config, _, _ = image.DecodeConfig(bytes.NewReader(data))
+	assert.Equal(t, imaging.MediumSize, config.Width)
+	assert.Equal(t, 600, config.Height)
+
+	data, _ = fetchImage(t, uploaded.ThumbURL)
+	config, _, _ = image.DecodeConfig(bytes.NewReader(data))
+	assert.Equal(t, imaging.ThumbSize, config.Width)
+	assert.Equal(t, 180, config.Height)
+}
+
+func TestUploadProductImageInvalid(t *testing.T) {
+	token := authorizeUser()
+
+	rv := uploadProductImage(token, "kecil.png", testImage(100, 100))
+	assert.Equal(t, imaging.ErrImageDimension.Error(), rv.Description)
+
+	rv = uploadProductImage(token, "dokumen.pdf", []byte("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"))
+	assert.Equal(t, imaging.ErrImageType.Error(), rv.Description)
+}
+
+func TestUploadProductImageStripsExif(t *testing.T) {
+	token := authorizeUser()
+
+	// orientation 6: foto portrait yang disimpan kamera dalam posisi landscape
+	rv := uploadProductImage(token, "foto.jpg", jpegWithOrientation(480, 360, 6))
+	assert.Equal(t, 0, rv.Code)
+	uploaded := toProductImage(rv.Result)
+	assert.Equal(t, 360, uploaded.Width)
+	assert.Equal(t, 480, uploaded.Height)
+
+	data, _ := fetchImage(t, uploaded.ImageURL)
+	assert.False(t, bytes.Contains(data, []byte("Exif\x00\x00")))
+}
+
+func TestProductImageFileOutsidePrefix(t *testing.T) {
+	for _, key := range []string{"chat/1/rahasia.pdf", "products/../chat/1/rahasia.pdf"} {
+		rv := reqGET(endpoint.ProductImageFile+"?key="+key, "")
+		assert.Equal(t, imaging.ErrImageNotFound.Error(), rv.Description)
+	}
+}
+
+func TestProductImagesOrder(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	first := uploadTestImage(token)
+	second := uploadTestImage(token)
+
+	payload := repository.NewProductQuery{
+		StoreID:      store.ID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{second, first},
+		Desc:         faker.RandomString(100),
+		Condition:    1,
+		ConditionAvg: 100,
+		StartPrice:   50000,
+		BidMultpl:    50000,
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       []repository.LabelQuery{{Name: "label_name", Value: "value"}},
+	}
+	rv := reqPOST(endpoint.AddProduct, payload, token)
+	assert.Equal(t, 0, rv.Code)
+	productID := int64(rv.Result.(map[string]interface{})["id"].(float64))
+
+	productRepo := repository.NewProductRepository()
+	images, _ := productRepo.GetProductImages(productID)
+	assert.Equal(t, 2, len(images))
+	assert.Equal(t, second, images[0].ID)
+	assert.Equal(t, true, images[0].IsPrimary)
+	assert.Equal(t, first, images[1].ID)
+	assert.Equal(t, false, images[1].IsPrimary)
+
+	// gambar yang tidak ada lagi di list dihapus beserta file-nya
+	removed, err := productRepo.ReplaceProductImages(productID, 0, []int64{first})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(removed))
+	images, _ = productRepo.GetProductImages(productID)
+	assert.Equal(t, 1, len(images))
+	assert.Equal(t, true, images[0].IsPrimary)
+}
+
+func TestAddProductWithForeignImage(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	foreign := uploadTestImage(authorizeUser())
+
+	payload := repository.NewProductQuery{
+		StoreID:      store.ID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{foreign},
+		Desc:         faker.RandomString(100),
+		Condition:    1,
+		ConditionAvg: 100,
+		StartPrice:   50000,
+		BidMultpl:    50000,
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       []repository.LabelQuery{{Name: "label_name", Value: "value"}},
+	}
+	rv := reqPOST(endpoint.AddProduct, payload, token)
+	assert.Equal(t, repository.ErrInvalidProductImages.Error(), rv.Description)
+}
+
+func TestPrunePendingImage(t *testing.T) {
+	token := authorizeUser()
+	rv := uploadProductImage(token, "produk.png", testImage(400, 400))
+	uploaded := toProductImage(rv.Result)
+
+	job := imaging.PrunePendingImageJob{ImageID: uploaded.ID}
+	assert.Nil(t, job.Handle())
+
+	_, err := repository.NewProductRepository().GetProductImage(uploaded.ID)
+	assert.NotNil(t, err)
+	rv = parseResult(http.Get(ts.URL + uploaded.ThumbURL))
+	assert.Equal(t, imaging.ErrImageNotFound.Error(), rv.Description)
+}
+
+func TestUpdateProductFailureKeepsImages(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	product, _ := createProduct(token, store.ID)
+	productRepo := repository.NewProductRepository()
+	images, _ := productRepo.GetProductImages(product.ID)
+
+	payload := repository.UpdateProductQuery{
+		ID:           product.ID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{uploadTestImage(token)},
+		Desc:         faker.RandomString(100),
+		Condition:    2,
+		ConditionAvg: 90,
+		StartPrice:   50000,
+		BidMultpl:    50000,
+		ClosedAT:     "bukan-tanggal",
+		Labels:       []repository.LabelQuery{{Name: "label_name", Value: "value"}},
+	}
+	rv := reqPOST(endpoint.UpdateProduct, payload, token)
+	assert.Equal(t, 4000, rv.Code)
+
+	// gambar dari user lain membatalkan seluruh update, termasuk perubahan nama product
+	payload.ClosedAT = utils.NOW.Add(time.Hour * 48).Format(time.RFC3339)
+	payload.ImageIDs = append(payload.ImageIDs, uploadTestImage(authorizeUser()))
+	rv = reqPOST(endpoint.UpdateProduct, payload, token)
+	assert.Equal(t, repository.ErrInvalidProductImages.Error(), rv.Description)
+
+	current, _ := productRepo.GetByID(product.ID)
+	assert.Equal(t, product.ProductName, current.ProductName)
+	after, _ := productRepo.GetProductImages(product.ID)
+	assert.Equal(t, len(images), len(after))
+	assert.Equal(t, images[0].ID, after[0].ID)
+	_, resp := fetchImage(t, images[0].ThumbURL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
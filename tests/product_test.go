@@ -26,16 +26,16 @@ func TestAddProduct(t *testing.T) {
 	token := authorizeUser()
 	store := upgradeUser(token)
 	payload := repository.NewProductQuery{
-		StoreID:       store.ID,
-		ProductName:   faker.Commerce().ProductName(),
-		ProductImages: []string{faker.Internet().Url()},
-		Desc:          faker.RandomString(100),
-		Condition:     1,
-		ConditionAvg:  100,
-		StartPrice:    float64(faker.Commerce().Price()),
-		BidMultpl:     float64(faker.Commerce().Price()),
-		ClosedAT:      utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
-		Labels:        labels,
+		StoreID:      store.ID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{uploadTestImage(token)},
+		Desc:         faker.RandomString(100),
+		Condition:    1,
+		ConditionAvg: 100,
+		StartPrice:   float64(faker.Commerce().Price()),
+		BidMultpl:    float64(faker.Commerce().Price()),
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       labels,
 	}
 
 	rv := reqPOST(endpoint.AddProduct, payload, token)
@@ -161,16 +161,16 @@ func TestUpdateProduct(t *testing.T) {
 		Value: "value",
 	})
 	payload := repository.UpdateProductQuery{
-		ID:            product.ID,
-		ProductName:   faker.Commerce().ProductName(),
-		ProductImages: []string{faker.Internet().Url()},
-		Desc:          faker.RandomString(100),
-		Condition:     2,
-		ConditionAvg:  90,
-		StartPrice:    50000.0,
-		BidMultpl:     50000.0,
-		ClosedAT:      utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
-		Labels:        labels,
+		ID:           product.ID,
+		ProductName:  faker.Commerce().ProductName(),
+		ImageIDs:     []int64{uploadTestImage(token)},
+		Desc:         faker.RandomString(100),
+		Condition:    2,
+		ConditionAvg: 90,
+		StartPrice:   50000.0,
+		BidMultpl:    50000.0,
+		ClosedAT:     utils.NOW.Add(time.Hour * 24).Format(time.RFC3339),
+		Labels:       labels,
 	}
 
 	rv := reqPOST(endpoint.UpdateProduct, payload, token)
@@ -0,0 +1,133 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/system/queue"
+	"github.com/go-playground/assert/v2"
+	"syreclabs.com/go/faker"
+)
+
+var handledJobs sync.Map
+
+type testJob struct {
+	Token string `json:"token"`
+	Fail  bool   `json:"fail"`
+}
+
+func (j *testJob) Handle() error {
+	if j.Fail {
+		return errors.New("failed on purpose")
+	}
+	handledJobs.Store(j.Token, true)
+	return nil
+}
+
+func (j *testJob) MaxAttempts() int {
+	return 1
+}
+
+func init() {
+	queue.Register(&testJob{})
+}
+
+func runDispatcher(t *testing.T, wait func() bool) {
+	dispatcher := queue.NewDispatcher(1)
+	dispatcher.Run()
+	defer dispatcher.Stop(context.Background())
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !wait() {
+		if time.Now().After(deadline) {
+			t.Fatal("job was not processed in time")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestQueueHandleJob(t *testing.T) {
+	token := faker.RandomString(16)
+	err := queue.JobQueue.Push(&testJob{Token: token})
+	assert.Equal(t, err, nil)
+
+	runDispatcher(t, func() bool {
+		_, ok := handledJobs.Load(token)
+		return ok
+	})
+}
+
+func TestQueueDeadLetter(t *testing.T) {
+	token := faker.RandomString(16)
+	err := queue.JobQueue.Push(&testJob{Token: token, Fail: true})
+	assert.Equal(t, err, nil)
+
+	runDispatcher(t, func() bool {
+		count, _ := models.NewDeadJobQuerySet(app.DB).PayloadLike("%" + token + "%").Count()
+		return count == 1
+	})
+}
+
+func TestQueueUnregisteredJob(t *testing.T) {
+	type unknownJob struct{ testJob }
+	err := queue.JobQueue.Push(&unknownJob{})
+	assert.NotEqual(t, err, nil)
+}
+
+func TestQueueDelayedJob(t *testing.T) {
+	token := faker.RandomString(16)
+	err := queue.JobQueue.Push(&testJob{Token: token}, queue.Delay(time.Second))
+	assert.Equal(t, err, nil)
+
+	start := time.Now()
+	runDispatcher(t, func() bool {
+		_, ok := handledJobs.Load(token)
+		return ok
+	})
+	assert.Equal(t, time.Since(start) >= time.Second, true)
+}
+
+func TestQueueUniqueKeyReplacesPendingJob(t *testing.T) {
+	key := "test:" + faker.RandomString(16)
+	err := queue.JobQueue.Push(&testJob{Token: "first"}, queue.Delay(time.Hour), queue.Key(key))
+	assert.Equal(t, err, nil)
+	err = queue.JobQueue.Push(&testJob{Token: "second"}, queue.Delay(time.Hour), queue.Key(key))
+	assert.Equal(t, err, nil)
+
+	jobs := []models.Job{}
+	models.NewJobQuerySet(app.DB).UniqueKeyEq(key).All(&jobs)
+	assert.Equal(t, len(jobs), 1)
+	assert.Equal(t, jobs[0].Payload, `{"token":"second","fail":false}`)
+
+	models.NewJobQuerySet(app.DB).UniqueKeyEq(key).Delete()
+}
+
+func TestQueueUniqueKeyFollowsRunningJob(t *testing.T) {
+	key := "test:" + faker.RandomString(16)
+	err := queue.JobQueue.Push(&testJob{Token: "first"}, queue.Delay(time.Hour), queue.Key(key))
+	assert.Equal(t, err, nil)
+
+	// job pertama sedang dikerjakan worker
+	now := time.Now().UTC()
+	models.NewJobQuerySet(app.DB).UniqueKeyEq(key).GetUpdater().SetLockedAT(&now).Update()
+
+	err = queue.JobQueue.Push(&testJob{Token: "second"}, queue.Delay(time.Hour), queue.Key(key))
+	assert.Equal(t, err, nil)
+
+	jobs := []models.Job{}
+	models.NewJobQuerySet(app.DB).UniqueKeyEq(key + ":next").All(&jobs)
+	assert.Equal(t, len(jobs), 1)
+	assert.Equal(t, jobs[0].Payload, `{"token":"second","fail":false}`)
+
+	models.NewJobQuerySet(app.DB).UniqueKeyIn(key, key+":next").Delete()
+}
+
+func TestQueueInvalidCronSpec(t *testing.T) {
+	err := queue.JobQueue.Schedule("test-invalid", "not a spec", &testJob{})
+	assert.NotEqual(t, err, nil)
+}
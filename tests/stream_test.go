@@ -0,0 +1,148 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+type sseEvent struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+// openStream buka stream SSE, events ditutup ketika stream selesai atau ctx dibatalkan
+func openStream(ctx context.Context, t *testing.T, query string, lastEventID string) (*http.Response, chan sseEvent) {
+	req, _ := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api%s?%s", ts.URL, endpoint.StreamEvents, query), nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(chan sseEvent, 100)
+	go func() {
+		defer close(events)
+		defer resp.Body.Close()
+		scanner := bufio.NewScanner(resp.Body)
+		ev := sseEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if ev.Event != "" {
+					events <- ev
+				}
+				ev = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				ev.ID = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				ev.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				ev.Data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+	return resp, events
+}
+
+func waitEvent(t *testing.T, events chan sseEvent, name string, contains string) sseEvent {
+	for ev := range events {
+		if ev.Event == name && strings.Contains(ev.Data, contains) {
+			return ev
+		}
+	}
+	t.Fatalf("event %s not received", name)
+	return sseEvent{}
+}
+
+func TestStreamEventsUnauthorized(t *testing.T) {
+	rv := reqGET(endpoint.StreamEvents+"?token=invalid", "")
+	assert.Equal(t, 4010, rv.Code)
+}
+
+func TestStreamEventsTooManyProducts(t *testing.T) {
+	token := authorizeUser()
+	query := "token=" + token
+	for i := 1; i <= 21; i++ {
+		query += fmt.Sprintf("&product_id=%d", i)
+	}
+
+	rv := reqGET(endpoint.StreamEvents+"?"+query, "")
+	assert.NotEqual(t, 0, rv.Code)
+}
+
+func TestStreamEventsResume(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+
+	lastMessageID, _ := chatRepo.LastMessageID()
+	lastNotifID, _ := repository.NewNotifRepository().LastNotifID()
+	lastAuctionID, _ := repository.NewAuctionRepository().LastAuctionEventID()
+
+	reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, Text: "Terlewat saat offline"}, senderToken)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 8*time.Second)
+	defer cancel()
+	resp, events := openStream(ctx, t, "token="+receiverToken, fmt.Sprintf("%d-%d-%d", lastNotifID, lastMessageID, lastAuctionID))
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	ev := waitEvent(t, events, "reply", "Terlewat saat offline")
+	assert.NotEqual(t, "", ev.ID)
+	waitEvent(t, events, "notif", fmt.Sprintf(`"target_id":%d`, room.ID))
+}
+
+func TestStreamEventsLive(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, receiverToken := authorizeUserWithID()
+	room, _ := repository.NewChatRepository().CreateChat(senderID, receiverID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, events := openStream(ctx, t, "token="+receiverToken, "")
+
+	reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, Text: "Masih ada?"}, senderToken)
+	waitEvent(t, events, "reply", "Masih ada?")
+}
+
+func TestUserMessagesAfter(t *testing.T) {
+	senderID, senderToken := authorizeUserWithID()
+	receiverID, _ := authorizeUserWithID()
+	outsiderID, _ := authorizeUserWithID()
+	chatRepo := repository.NewChatRepository()
+	room, _ := chatRepo.CreateChat(senderID, receiverID)
+
+	ids := []int64{}
+	for _, text := range []string{"Satu", "Dua", "Tiga"} {
+		rv := reqPOST(endpoint.SendMessage, repository.ChatMessageQuery{ChatID: room.ID, Text: text}, senderToken)
+		ids = append(ids, int64(rv.Result.(map[string]interface{})["id"].(float64)))
+	}
+
+	// replay hanya message setelah id yang diberikan, dari yang terlama
+	messages, err := chatRepo.GetUserMessagesAfter(receiverID, ids[0], 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(messages))
+	assert.Equal(t, ids[1], messages[0].ID)
+	assert.Equal(t, "Tiga", messages[1].Text)
+
+	messages, _ = chatRepo.GetUserMessagesAfter(receiverID, ids[0], 1)
+	assert.Equal(t, 1, len(messages))
+	assert.Equal(t, ids[1], messages[0].ID)
+
+	// message milik chat room lain tidak ikut
+	messages, _ = chatRepo.GetUserMessagesAfter(outsiderID, ids[0], 10)
+	assert.Equal(t, 0, len(messages))
+}
@@ -72,3 +72,43 @@ func TestUpdateUser(t *testing.T) {
 
 	// defer cleanUsers()
 }
+
+func TestUserConnectMultipleDevices(t *testing.T) {
+	userID, _, _ := generateUserThenActivate()
+	repo := repository.NewUserRepository()
+	android := faker.RandomString(32)
+	apple := faker.RandomString(32)
+
+	assert.Equal(t, repo.CreateUserConnect(userID, android, "android", "1.0.0"), nil)
+	assert.Equal(t, repo.CreateUserConnect(userID, apple, "apple", "1.0.0"), nil)
+	// register ulang app id yang sama hanya memperbarui device
+	assert.Equal(t, repo.CreateUserConnect(userID, android, "android", "1.1.0"), nil)
+
+	conns, _ := repo.GetUserConnects(userID)
+	assert.Equal(t, len(conns), 2)
+	assert.Equal(t, conns[0].AppID, android)
+	assert.Equal(t, conns[0].AppVersion, "1.1.0")
+
+	canonical := faker.RandomString(32)
+	assert.Equal(t, repo.ReplaceAppID(apple, canonical), nil)
+	assert.Equal(t, repo.PruneAppIDs(android), nil)
+
+	conns, _ = repo.GetUserConnects(userID)
+	assert.Equal(t, len(conns), 1)
+	assert.Equal(t, conns[0].AppID, canonical)
+}
+
+func TestConnectRemoveSingleDevice(t *testing.T) {
+	token := authorizeUser()
+	first := service.ConnectCreateQuery{AppID: faker.RandomString(32), ProviderName: "android", AppVersion: "1.0.0"}
+	second := service.ConnectCreateQuery{AppID: faker.RandomString(32), ProviderName: "apple", AppVersion: "1.0.0"}
+
+	assert.Equal(t, reqPOST(endpoint.ConnectCreate, first, token).Code, 0)
+	assert.Equal(t, reqPOST(endpoint.ConnectCreate, second, token).Code, 0)
+
+	rv := reqPOST(endpoint.ConnectRemove, service.ConnectRemoveQuery{AppID: first.AppID}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	rv = reqPOST(endpoint.ConnectRemove, service.ConnectRemoveQuery{}, token)
+	assert.Equal(t, rv.Code, 0)
+}
@@ -0,0 +1,228 @@
+package test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fatkhur1960/goauction/app"
+	"github.com/fatkhur1960/goauction/app/models"
+	"github.com/fatkhur1960/goauction/app/repository"
+	"github.com/fatkhur1960/goauction/app/service"
+	"github.com/fatkhur1960/goauction/system/webhook"
+	"github.com/fatkhur1960/goauction/tests/endpoint"
+	"github.com/go-playground/assert/v2"
+)
+
+// webhookReceiver httptest server yang mencatat request dan memverifikasi signature
+type webhookReceiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	secret   string
+	status   int
+	received []string
+	verified []bool
+}
+
+func newWebhookReceiver(status int) *webhookReceiver {
+	r := &webhookReceiver{status: status}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		timestamp, _ := strconv.ParseInt(req.Header.Get(webhook.HeaderTimestamp), 10, 64)
+
+		r.mu.Lock()
+		r.received = append(r.received, req.Header.Get(webhook.HeaderEvent))
+		r.verified = append(r.verified, webhook.Verify(r.secret, timestamp, body, req.Header.Get(webhook.HeaderSignature)))
+		r.mu.Unlock()
+
+		w.WriteHeader(r.status)
+		w.Write([]byte("internal receiver detail"))
+	}))
+	return r
+}
+
+func (r *webhookReceiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.received)
+}
+
+func addWebhook(t *testing.T, token string, url string, eventTypes ...string) models.StoreWebhook {
+	payload := repository.NewWebhookQuery{
+		URL:        url,
+		EventTypes: eventTypes,
+	}
+	rv := reqPOST(endpoint.AddWebhook, payload, token)
+	assert.Equal(t, rv.Code, 0)
+
+	hook := mapToJSON(rv.Result.(map[string]interface{}), &models.StoreWebhook{}).(*models.StoreWebhook)
+	return *hook
+}
+
+func TestWebhookSignature(t *testing.T) {
+	body := []byte(`{"event":"bid.created"}`)
+	signature := webhook.Sign("secret", 1600000000, body)
+
+	assert.Equal(t, webhook.Verify("secret", 1600000000, body, signature), true)
+	assert.Equal(t, webhook.Verify("other", 1600000000, body, signature), false)
+	assert.Equal(t, webhook.Verify("secret", 1600000001, body, signature), false)
+}
+
+func TestAddWebhookInvalidEventType(t *testing.T) {
+	token := authorizeUser()
+	upgradeUser(token)
+
+	payload := repository.NewWebhookQuery{
+		URL:        "https://example.com/hook",
+		EventTypes: []string{"unknown.event"},
+	}
+	rv := reqPOST(endpoint.AddWebhook, payload, token)
+	assert.NotEqual(t, rv.Code, 0)
+}
+
+func TestWebhookDeliverySigned(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	token := authorizeUser()
+	store := upgradeUser(token)
+	hook := addWebhook(t, token, receiver.URL, webhook.BidCreated)
+	assert.NotEqual(t, hook.Secret, "")
+	receiver.secret = hook.Secret
+
+	// event yang tidak dipilih tidak dikirim
+	assert.Equal(t, webhook.Enqueue(store.ID, webhook.ProductSold, map[string]interface{}{}), nil)
+	assert.Equal(t, webhook.Enqueue(store.ID, webhook.BidCreated, map[string]interface{}{"product_id": 1}), nil)
+
+	runDispatcher(t, func() bool {
+		return receiver.count() == 1
+	})
+
+	assert.Equal(t, receiver.received, []string{webhook.BidCreated})
+	assert.Equal(t, receiver.verified, []bool{true})
+
+	deliveries := []models.WebhookDelivery{}
+	models.NewWebhookDeliveryQuerySet(app.DB).WebhookIDEq(hook.ID).All(&deliveries)
+	assert.Equal(t, len(deliveries), 1)
+	assert.Equal(t, deliveries[0].Status, models.DeliverySuccess)
+	assert.Equal(t, deliveries[0].Attempts, 1)
+}
+
+func TestWebhookFailedAttemptIsLogged(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusInternalServerError)
+	defer receiver.Close()
+
+	token := authorizeUser()
+	store := upgradeUser(token)
+	hook := addWebhook(t, token, receiver.URL, webhook.AuctionClosed)
+	receiver.secret = hook.Secret
+
+	assert.Equal(t, webhook.Enqueue(store.ID, webhook.AuctionClosed, map[string]interface{}{}), nil)
+
+	runDispatcher(t, func() bool {
+		return receiver.count() == 1
+	})
+
+	delivery := models.WebhookDelivery{}
+	models.NewWebhookDeliveryQuerySet(app.DB).WebhookIDEq(hook.ID).One(&delivery)
+	assert.Equal(t, delivery.Status, models.DeliveryPending)
+	assert.Equal(t, delivery.Attempts, 1)
+	assert.Equal(t, delivery.LastStatusCode, http.StatusInternalServerError)
+	// response body receiver tidak ikut disimpan
+	assert.Equal(t, delivery.LastError, "receiver responded 500")
+
+	attempts := []models.WebhookDeliveryAttempt{}
+	models.NewWebhookDeliveryAttemptQuerySet(app.DB).DeliveryIDEq(delivery.ID).All(&attempts)
+	assert.Equal(t, len(attempts), 1)
+	assert.Equal(t, attempts[0].StatusCode, http.StatusInternalServerError)
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	token := authorizeUser()
+	store := upgradeUser(token)
+	hook := addWebhook(t, token, receiver.URL, webhook.ProductSold)
+	receiver.secret = hook.Secret
+
+	assert.Equal(t, webhook.Enqueue(store.ID, webhook.ProductSold, map[string]interface{}{}), nil)
+	runDispatcher(t, func() bool {
+		return receiver.count() == 1
+	})
+
+	delivery := models.WebhookDelivery{}
+	models.NewWebhookDeliveryQuerySet(app.DB).WebhookIDEq(hook.ID).One(&delivery)
+
+	rv := reqPOST(endpoint.Redeliver, service.IDQuery{ID: delivery.ID}, token)
+	assert.Equal(t, rv.Code, 0)
+
+	runDispatcher(t, func() bool {
+		return receiver.count() == 2
+	})
+	assert.Equal(t, receiver.verified, []bool{true, true})
+}
+
+func TestRedeliverWebhookUnauthorized(t *testing.T) {
+	token := authorizeUser()
+	store := upgradeUser(token)
+	hook := addWebhook(t, token, "https://example.com/hook", webhook.ProductSold)
+	models.NewStoreWebhookQuerySet(app.DB).IDEq(hook.ID).GetUpdater().SetActive(false).Update()
+	webhook.Enqueue(store.ID, webhook.ProductSold, map[string]interface{}{})
+
+	delivery := models.WebhookDelivery{}
+	models.NewWebhookDeliveryQuerySet(app.DB).WebhookIDEq(hook.ID).One(&delivery)
+
+	otherToken := authorizeUser()
+	upgradeUser(otherToken)
+	rv := reqPOST(endpoint.Redeliver, service.IDQuery{ID: delivery.ID}, otherToken)
+	assert.NotEqual(t, rv.Code, 0)
+}
+
+func TestAddWebhookPrivateTarget(t *testing.T) {
+	webhook.AllowPrivateTargets = false
+	defer func() { webhook.AllowPrivateTargets = true }()
+
+	token := authorizeUser()
+	upgradeUser(token)
+
+	for _, url := range []string{
+		"http://127.0.0.1:8080/hook",
+		"http://localhost/hook",
+		"http://10.1.2.3/hook",
+		"http://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://[::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	} {
+		rv := reqPOST(endpoint.AddWebhook, repository.NewWebhookQuery{URL: url, EventTypes: []string{webhook.ProductSold}}, token)
+		assert.Equal(t, rv.Description, webhook.ErrPrivateTarget.Error())
+	}
+}
+
+func TestWebhookDeliveryPrivateTargetRejectedAtDial(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusOK)
+	defer receiver.Close()
+
+	token := authorizeUser()
+	store := upgradeUser(token)
+	hook := addWebhook(t, token, receiver.URL, webhook.ProductSold)
+	assert.Equal(t, webhook.Enqueue(store.ID, webhook.ProductSold, map[string]interface{}{}), nil)
+
+	delivery := models.WebhookDelivery{}
+	models.NewWebhookDeliveryQuerySet(app.DB).WebhookIDEq(hook.ID).One(&delivery)
+
+	// url yang lolos saat didaftarkan tetap ditolak jika saat dial mengarah ke ip internal
+	webhook.AllowPrivateTargets = false
+	defer func() { webhook.AllowPrivateTargets = true }()
+
+	job := webhook.DeliverJob{DeliveryID: delivery.ID}
+	err := job.Handle()
+	assert.NotEqual(t, err, nil)
+	assert.Equal(t, strings.Contains(err.Error(), webhook.ErrPrivateTarget.Error()), true)
+	assert.Equal(t, receiver.count(), 0)
+}